@@ -101,6 +101,7 @@ import (
 	leveragekeeper "github.com/umee-network/umee/x/leverage/keeper"
 	leveragetypes "github.com/umee-network/umee/x/leverage/types"
 	"github.com/umee-network/umee/x/oracle"
+	oracleclient "github.com/umee-network/umee/x/oracle/client"
 	oraclekeeper "github.com/umee-network/umee/x/oracle/keeper"
 	oracletypes "github.com/umee-network/umee/x/oracle/types"
 )
@@ -382,6 +383,7 @@ func New(
 		app.BankKeeper,
 		app.DistrKeeper,
 		&stakingKeeper,
+		app.SlashingKeeper,
 		distrtypes.ModuleName,
 	)
 	app.LeverageKeeper = leveragekeeper.NewKeeper(
@@ -459,6 +461,7 @@ func New(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(leveragetypes.RouterKey, leverage.NewUpdateRegistryProposalHandler(app.LeverageKeeper)).
+		AddRoute(oracletypes.RouterKey, oracle.NewExchangeRateOverrideProposalHandler(app.OracleKeeper)).
 		AddRoute(gravitytypes.RouterKey, gravitykeeper.NewGravityProposalHandler(app.GravityKeeper))
 
 	// Create evidence Keeper so we can register the IBC light client misbehavior
@@ -511,7 +514,7 @@ func New(
 		transferModule,
 		gravity.NewAppModule(app.GravityKeeper, app.BankKeeper),
 		leverage.NewAppModule(appCodec, app.LeverageKeeper, app.AccountKeeper, app.BankKeeper),
-		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
+		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper, app.LeverageKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that there
@@ -618,7 +621,7 @@ func New(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
-		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
+		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper, app.LeverageKeeper),
 		// TODO: Ensure x/leverage implements simulator and then uncomment.
 		// leverage.NewAppModule(appCodec, app.LeverageKeeper, app.AccountKeeper, app.BankKeeper),
 	)
@@ -855,6 +858,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		upgradeclient.ProposalHandler,
 		upgradeclient.CancelProposalHandler,
 		leverageclient.ProposalHandler,
+		oracleclient.ProposalHandler,
 	}
 }
 
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+
+	"github.com/umee-network/umee/price-feeder/config"
+	"github.com/umee-network/umee/price-feeder/oracle"
+)
+
+// configReloadDelay defines how long to wait for writes to the config file to
+// settle before reloading it, as editors usually emit several events per save.
+const configReloadDelay = time.Second
+
+// startConfigWatcher reloads the configuration whenever the config file
+// changes or a SIGHUP is received, and applies the new currency pairs,
+// deviation thresholds and provider endpoints to the running oracle until ctx
+// is canceled. An invalid configuration is logged and ignored. If the config
+// file cannot be watched, it is only reloaded on SIGHUP.
+func startConfigWatcher(
+	ctx context.Context,
+	logger zerolog.Logger,
+	configPath string,
+	cfg config.Config,
+	oracle *oracle.Oracle,
+) {
+	configPath = filepath.Clean(configPath)
+
+	var (
+		events <-chan fsnotify.Event
+		errs   <-chan error
+	)
+	watcher, err := newConfigWatcher(configPath)
+	if err != nil {
+		logger.Err(err).Msg("failed to watch config file; send SIGHUP to reload it")
+	} else {
+		defer watcher.Close()
+		events, errs = watcher.Events, watcher.Errors
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	var reloadCh <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+
+			if filepath.Clean(event.Name) == configPath && event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				reloadCh = time.After(configReloadDelay)
+			}
+
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+
+			logger.Err(err).Msg("failed to watch config file")
+
+		case sig := <-sigCh:
+			logger.Info().Str("signal", sig.String()).Msg("caught signal; reloading config...")
+			cfg = reloadConfig(logger, configPath, cfg, oracle)
+
+		case <-reloadCh:
+			reloadCh = nil
+
+			logger.Info().Str("config", configPath).Msg("config file changed; reloading config...")
+			cfg = reloadConfig(logger, configPath, cfg, oracle)
+		}
+	}
+}
+
+// newConfigWatcher returns a watcher of the parent directory of the config
+// file rather than of the file, since editors often replace the file instead of
+// writing to it.
+func newConfigWatcher(configPath string) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config watcher: %w", err)
+	}
+
+	if err := watcher.Add(filepath.Dir(configPath)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("failed to watch config file: %w", err)
+	}
+
+	return watcher, nil
+}
+
+// reloadConfig parses and validates the config file and applies its currency
+// pairs, deviation thresholds and provider endpoints to the oracle. Any other
+// change only takes effect after a restart, so the returned configuration in
+// effect afterwards is the running one with the applied settings only.
+func reloadConfig(
+	logger zerolog.Logger,
+	configPath string,
+	cfg config.Config,
+	oracle *oracle.Oracle,
+) config.Config {
+	newCfg, err := config.ParseConfig(configPath)
+	if err != nil {
+		logger.Err(err).Msg("failed to reload config; keeping the current config")
+		return cfg
+	}
+
+	if cfg.RestartRequired(newCfg) {
+		logger.Warn().Msg(
+			"only currency pairs, deviation thresholds and provider endpoints are reloaded; " +
+				"restart the price-feeder to apply other changes",
+		)
+	}
+
+	oracle.UpdateDeviations(newCfg.Deviations)
+	oracle.UpdateEndpoints(newCfg.ProviderEndpoints)
+	oracle.UpdateCurrencyPairs(newCfg.CurrencyPairs)
+
+	cfg.CurrencyPairs = newCfg.CurrencyPairs
+	cfg.Deviations = newCfg.Deviations
+	cfg.ProviderEndpoints = newCfg.ProviderEndpoints
+
+	logger.Info().
+		Int("currency_pairs", len(cfg.CurrencyPairs)).
+		Int("deviation_thresholds", len(cfg.Deviations)).
+		Int("provider_endpoints", len(cfg.ProviderEndpoints)).
+		Msg("config reloaded")
+
+	return cfg
+}
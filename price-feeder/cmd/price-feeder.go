@@ -3,6 +3,7 @@ package cmd
 import (
 	"bufio"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	input "github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog"
@@ -22,6 +24,7 @@ import (
 	"github.com/umee-network/umee/price-feeder/config"
 	"github.com/umee-network/umee/price-feeder/oracle"
 	"github.com/umee-network/umee/price-feeder/oracle/client"
+	"github.com/umee-network/umee/price-feeder/pkg/attestation"
 	v1 "github.com/umee-network/umee/price-feeder/router/v1"
 	"github.com/umee-network/umee/price-feeder/telemetry"
 )
@@ -54,6 +57,7 @@ func init() {
 	rootCmd.PersistentFlags().String(flagLogFormat, logLevelText, "logging format; must be either json or text")
 
 	rootCmd.AddCommand(getVersionCmd())
+	rootCmd.AddCommand(getRecordCmd())
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -66,101 +70,182 @@ func Execute() {
 }
 
 func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
-	logLvlStr, err := cmd.Flags().GetString(flagLogLevel)
+	logger, err := getLogger(cmd)
 	if err != nil {
 		return err
 	}
 
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	cfg, err := config.ParseConfig(args[0])
 	if err != nil {
 		return err
 	}
 
-	logFormatStr, err := cmd.Flags().GetString(flagLogFormat)
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	// listen for and trap any OS signal to gracefully shutdown and exit
+	trapSignal(cancel, logger)
+
+	timeout, err := time.ParseDuration(cfg.RPC.RPCTimeout)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to parse RPC timeout: %w", err)
 	}
 
-	var logWriter io.Writer
-	if strings.ToLower(logFormatStr) == "text" {
-		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
-	} else {
-		logWriter = os.Stderr
+	healthCheckInterval, err := time.ParseDuration(cfg.RPC.HealthCheckInterval)
+	if err != nil {
+		return fmt.Errorf("failed to parse RPC health check interval: %w", err)
 	}
 
-	switch strings.ToLower(logFormatStr) {
-	case logLevelJSON:
-		logWriter = os.Stderr
-
-	case logLevelText:
-		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
-
-	default:
-		return fmt.Errorf("invalid logging format: %s", logFormatStr)
+	var nodeEndpoints []client.NodeEndpoints
+	for _, n := range cfg.RPC.NodeEndpoints() {
+		nodeEndpoints = append(nodeEndpoints, client.NodeEndpoints{
+			TMRPC: n.TMRPCEndpoint,
+			GRPC:  n.GRPCEndpoint,
+		})
 	}
 
-	logger := zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()
-
-	cfg, err := config.ParseConfig(args[0])
+	oracleClient, err := newOracleClient(ctx, logger, cfg, nodeEndpoints, timeout, healthCheckInterval)
 	if err != nil {
 		return err
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	g, ctx := errgroup.WithContext(ctx)
-
-	// listen for and trap any OS signal to gracefully shutdown and exit
-	trapSignal(cancel, logger)
-
-	timeout, err := time.ParseDuration(cfg.RPC.RPCTimeout)
+	tvwapWindow, err := time.ParseDuration(cfg.Candles.TVWAPWindow)
 	if err != nil {
-		return fmt.Errorf("failed to parse RPC timeout: %w", err)
+		return fmt.Errorf("failed to parse TVWAP window: %w", err)
 	}
 
-	// Gather pass via env variable || std input
-	keyringPass, err := getKeyringPassword()
+	maxCandleAge, err := time.ParseDuration(cfg.Candles.MaxAge)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to parse max candle age: %w", err)
 	}
 
-	oracleClient, err := client.NewOracleClient(
+	oracle := oracle.New(
 		logger,
-		cfg.Account.ChainID,
-		cfg.Keyring.Backend,
-		cfg.Keyring.Dir,
-		keyringPass,
-		cfg.RPC.TMRPCEndpoint,
-		timeout,
-		cfg.Account.Address,
-		cfg.Account.Validator,
-		cfg.RPC.GRPCEndpoint,
-		cfg.GasAdjustment,
+		oracleClient,
+		oracle.Options{
+			CurrencyPairs: cfg.CurrencyPairs,
+			Endpoints:     cfg.ProviderEndpoints,
+			Deviations:    cfg.Deviations,
+			TVWAPWindow:   tvwapWindow,
+			MaxCandleAge:  maxCandleAge,
+			StateFile:     cfg.StateFile,
+			DryRun:        cfg.DryRun,
+		},
 	)
+
+	metrics, err := telemetry.New(cfg.Telemetry)
 	if err != nil {
 		return err
 	}
 
-	oracle := oracle.New(logger, oracleClient, cfg.CurrencyPairs)
-
-	metrics, err := telemetry.New(cfg.Telemetry)
+	attestor, err := newAttestor(logger, cfg)
 	if err != nil {
 		return err
 	}
 
 	g.Go(func() error {
 		// start the process that observes and publishes exchange prices
-		return startPriceFeeder(ctx, logger, cfg, oracle, metrics)
+		return startPriceFeeder(ctx, logger, cfg, oracle, metrics, attestor)
 	})
 	g.Go(func() error {
 		// start the process that calculates oracle prices and votes
 		return startPriceOracle(ctx, logger, oracle)
 	})
+	g.Go(func() error {
+		// start the process that applies changes to the config file
+		startConfigWatcher(ctx, logger, args[0], cfg, oracle)
+		return nil
+	})
 
 	// Block main process until all spawned goroutines have gracefully exited and
 	// signal has been captured in the main process or if an error occurs.
 	return g.Wait()
 }
 
+// newOracleClient returns the client through which the oracle interacts with
+// the chain. In dry-run mode, the client only queries the chain, so that no
+// feeder account nor signer is needed.
+func newOracleClient(
+	ctx context.Context,
+	logger zerolog.Logger,
+	cfg config.Config,
+	nodeEndpoints []client.NodeEndpoints,
+	rpcTimeout time.Duration,
+	healthCheckInterval time.Duration,
+) (client.OracleClient, error) {
+	if cfg.DryRun {
+		logger.Info().Msg("running in dry-run mode; no transactions will be signed nor broadcast")
+		return client.NewReadOnlyOracleClient(ctx, logger, nodeEndpoints, rpcTimeout, healthCheckInterval)
+	}
+
+	signer, err := newSigner(ctx, cfg)
+	if err != nil {
+		return client.OracleClient{}, err
+	}
+
+	return client.NewOracleClient(
+		ctx,
+		logger,
+		cfg.Account.ChainID,
+		signer,
+		nodeEndpoints,
+		rpcTimeout,
+		healthCheckInterval,
+		cfg.Account.Address,
+		cfg.Account.Validator,
+		cfg.GasAdjustment,
+		cfg.Tx.GasPrices,
+		cfg.Tx.Gas,
+		cfg.Tx.FeeGranter,
+		cfg.Tx.Memo,
+	)
+}
+
+// newAttestor returns the signer of the price attestations, or nil if no
+// attestation key is configured.
+func newAttestor(logger zerolog.Logger, cfg config.Config) (v1.Attestor, error) {
+	if len(cfg.Attestation.KeyFile) == 0 {
+		return nil, nil
+	}
+
+	privKey, err := attestation.LoadPrivKey(cfg.Attestation.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := attestation.NewSigner(privKey, cfg.Account.ChainID, cfg.Account.Validator)
+	logger.Info().
+		Str("pub_key", base64.StdEncoding.EncodeToString(signer.PubKey().Bytes())).
+		Msg("attesting prices")
+
+	return signer, nil
+}
+
+// newSigner returns the signer of the feeder account configured in cfg.
+func newSigner(ctx context.Context, cfg config.Config) (client.Signer, error) {
+	address, err := sdk.AccAddressFromBech32(cfg.Account.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Signer.Type == config.SignerTypeRemote {
+		timeout, err := time.ParseDuration(cfg.Signer.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signer timeout: %w", err)
+		}
+
+		return client.NewRemoteSigner(ctx, cfg.Signer.URL, timeout, address)
+	}
+
+	// Gather pass via env variable || std input
+	keyringPass, err := getKeyringPassword()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewKeyringSigner(cfg.Keyring.Backend, cfg.Keyring.Dir, keyringPass, address)
+}
+
 func getKeyringPassword() (string, error) {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -171,6 +256,44 @@ func getKeyringPassword() (string, error) {
 	return pass, nil
 }
 
+// getLogger returns the logger configured by the log level and format flags.
+func getLogger(cmd *cobra.Command) (zerolog.Logger, error) {
+	logLvlStr, err := cmd.Flags().GetString(flagLogLevel)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	logFormatStr, err := cmd.Flags().GetString(flagLogFormat)
+	if err != nil {
+		return zerolog.Logger{}, err
+	}
+
+	var logWriter io.Writer
+	if strings.ToLower(logFormatStr) == "text" {
+		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
+	} else {
+		logWriter = os.Stderr
+	}
+
+	switch strings.ToLower(logFormatStr) {
+	case logLevelJSON:
+		logWriter = os.Stderr
+
+	case logLevelText:
+		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
+
+	default:
+		return zerolog.Logger{}, fmt.Errorf("invalid logging format: %s", logFormatStr)
+	}
+
+	return zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger(), nil
+}
+
 // trapSignal will listen for any OS signal and invoke Done on the main
 // WaitGroup allowing the main process to gracefully exit.
 func trapSignal(cancel context.CancelFunc, logger zerolog.Logger) {
@@ -192,9 +315,10 @@ func startPriceFeeder(
 	cfg config.Config,
 	oracle *oracle.Oracle,
 	metrics *telemetry.Metrics,
+	attestor v1.Attestor,
 ) error {
 	rtr := mux.NewRouter()
-	v1Router := v1.New(logger, cfg, oracle, metrics)
+	v1Router := v1.New(logger, cfg, oracle, metrics, attestor)
 	v1Router.RegisterRoutes(rtr, v1.APIPathPrefix)
 
 	writeTimeout, err := time.ParseDuration(cfg.Server.WriteTimeout)
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
+)
+
+const (
+	flagOutput   = "output"
+	flagDuration = "duration"
+)
+
+func getRecordCmd() *cobra.Command {
+	recordCmd := &cobra.Command{
+		Use:   "record [provider] [base/quote]...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Record the feeds of an exchange provider to a file",
+		Long: `Record the raw websocket messages and REST responses an exchange provider
+receives for the given currency pairs, e.g. ATOM/USDT, to a file. The provider
+is connected to the exchange through a local proxy, which writes every message
+received from the exchange as a JSON encoded frame per line. Recordings can be
+replayed to providers in tests, without connecting to the exchange.`,
+		RunE: recordCmdHandler,
+	}
+
+	recordCmd.Flags().String(flagOutput, "", "file to write the recording to; defaults to <provider>.jsonl")
+	recordCmd.Flags().Duration(flagDuration, time.Minute, "duration of the recording")
+
+	return recordCmd
+}
+
+func recordCmdHandler(cmd *cobra.Command, args []string) error {
+	logger, err := getLogger(cmd)
+	if err != nil {
+		return err
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput)
+	if err != nil {
+		return err
+	}
+	if len(output) == 0 {
+		output = args[0] + ".jsonl"
+	}
+
+	duration, err := cmd.Flags().GetDuration(flagDuration)
+	if err != nil {
+		return err
+	}
+
+	r, ok := provider.Lookup(args[0])
+	if !ok {
+		return fmt.Errorf("unsupported provider: %s", args[0])
+	}
+
+	pairs := make([]types.CurrencyPair, len(args)-1)
+	for i, arg := range args[1:] {
+		assets := strings.Split(arg, "/")
+		if len(assets) != 2 || len(assets[0]) == 0 || len(assets[1]) == 0 {
+			return fmt.Errorf("invalid currency pair: %s", arg)
+		}
+
+		pairs[i] = types.CurrencyPair{
+			Base:  strings.ToUpper(assets[0]),
+			Quote: strings.ToUpper(assets[1]),
+		}
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	recorder := replay.NewRecorder(logger, r.Endpoints.Rest, r.Endpoints.Websocket, file)
+	defer recorder.Close()
+
+	rest, err := recorder.URL(r.Endpoints.Rest)
+	if err != nil {
+		return err
+	}
+	websocket, err := recorder.URL(r.Endpoints.Websocket)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+
+	// listen for and trap any OS signal to stop recording early
+	trapSignal(cancel, logger)
+
+	p, err := provider.New(ctx, logger, r.Name, provider.Endpoints{Rest: rest, Websocket: websocket}, pairs...)
+	if err != nil {
+		return err
+	}
+
+	logger.Info().
+		Str("provider", r.Name).
+		Str("output", output).
+		Dur("duration", duration).
+		Msg("recording provider feeds...")
+
+	// providers without a websocket only request the exchange when prices are
+	// requested
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info().Str("output", output).Msg("recording done")
+			return nil
+
+		case <-ticker.C:
+			if r.Capabilities.WebSocket {
+				continue
+			}
+
+			if _, err := p.GetTickerPrices(pairs...); err != nil {
+				logger.Debug().Err(err).Msg("failed to get ticker prices")
+			}
+			if r.Capabilities.Candles {
+				if _, err := p.GetCandlePrices(pairs...); err != nil {
+					logger.Debug().Err(err).Msg("failed to get candle prices")
+				}
+			}
+		}
+	}
+}
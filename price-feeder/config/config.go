@@ -4,27 +4,56 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"strings"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/BurntSushi/toml"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/go-playground/validator/v10"
+
+	"github.com/umee-network/umee/ante"
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
 )
 
 const (
-	denomUSD = "USD"
-
 	defaultListenAddr      = "0.0.0.0:7171"
 	defaultSrvWriteTimeout = 15 * time.Second
 	defaultSrvReadTimeout  = 15 * time.Second
 
-	ProviderKraken  = "kraken"
-	ProviderBinance = "binance"
-	ProviderOsmosis = "osmosis"
-	ProviderHuobi   = "huobi"
-	ProviderOkx     = "okx"
-	ProviderGate    = "gate"
-	ProviderMock    = "mock"
+	defaultStalePriceTimeout = 2 * time.Minute
+
+	defaultTVWAPWindow  = 3 * time.Minute
+	defaultMaxCandleAge = 3 * time.Minute
+
+	// CandleFallbackVWAP prices a pair whose candles are stale with the VWAP of
+	// its tickers.
+	CandleFallbackVWAP = "vwap"
+	// CandleFallbackAbstain discards the tickers of a pair whose candles are
+	// stale, so that the provider is not used for the pair at all.
+	CandleFallbackAbstain = "abstain"
+
+	// DeviationStrategySigma filters out the prices that are more than
+	// threshold standard deviations away from the mean.
+	DeviationStrategySigma = "sigma"
+	// DeviationStrategyMAD filters out the prices that are more than threshold
+	// median absolute deviations away from the median.
+	DeviationStrategyMAD = "mad"
+	// DeviationStrategyPercent filters out the prices that are more than
+	// threshold percent away from the median.
+	DeviationStrategyPercent = "percent"
+
+	defaultDeviationThreshold = "2"
+
+	// SignerTypeKeyring signs transactions with a key stored in a local keyring.
+	SignerTypeKeyring = "keyring"
+	// SignerTypeRemote signs transactions through a remote signing daemon.
+	SignerTypeRemote = "remote"
+
+	defaultSignerTimeout = 5 * time.Second
+
+	defaultHealthCheckInterval = 5 * time.Second
 )
 
 var (
@@ -32,47 +61,79 @@ var (
 
 	// ErrEmptyConfigPath defines a sentinel error for an empty config path.
 	ErrEmptyConfigPath = errors.New("empty configuration file path")
-
-	// SupportedProviders defines a lookup table of all the supported currency API
-	// providers.
-	SupportedProviders = map[string]struct{}{
-		ProviderKraken:  {},
-		ProviderBinance: {},
-		ProviderOsmosis: {},
-		ProviderOkx:     {},
-		ProviderHuobi:   {},
-		ProviderGate:    {},
-		ProviderMock:    {},
-	}
 )
 
 type (
-	// Config defines all necessary price-feeder configuration parameters.
+	// Config defines all necessary price-feeder configuration parameters. In
+	// DryRun mode, votes are computed and compared with the on-chain exchange
+	// rates, but never signed nor broadcast.
 	Config struct {
 		Server        Server         `toml:"server"`
+		Candles       Candles        `toml:"candles"`
 		CurrencyPairs []CurrencyPair `toml:"currency_pairs" validate:"required,gt=0,dive,required"`
-		Account       Account        `toml:"account" validate:"required,gt=0,dive,required"`
-		Keyring       Keyring        `toml:"keyring" validate:"required,gt=0,dive,required"`
+		Account       Account        `toml:"account" validate:"-"`
+		Keyring       Keyring        `toml:"keyring" validate:"-"`
+		Signer        Signer         `toml:"signer"`
+		Tx            Tx             `toml:"tx"`
+		Attestation   Attestation    `toml:"attestation"`
 		RPC           RPC            `toml:"rpc" validate:"required,gt=0,dive,required"`
 		Telemetry     Telemetry      `toml:"telemetry"`
-		GasAdjustment float64        `toml:"gas_adjustment" validate:"required"`
+		GasAdjustment float64        `toml:"gas_adjustment" validate:"required_unless=DryRun true"`
+		StateFile     string         `toml:"state_file"`
+		DryRun        bool           `toml:"dry_run"`
+
+		ProviderEndpoints []ProviderEndpoint `toml:"provider_endpoints" validate:"dive"`
+		Deviations        []Deviation        `toml:"deviation_thresholds" validate:"dive"`
 	}
 
-	// Server defines the API server configuration.
+	// Server defines the API server configuration. The health check reports a
+	// degraded status when prices were last synced more than StalePriceTimeout
+	// ago.
 	Server struct {
-		ListenAddr     string   `toml:"listen_addr"`
-		WriteTimeout   string   `toml:"write_timeout"`
-		ReadTimeout    string   `toml:"read_timeout"`
-		VerboseCORS    bool     `toml:"verbose_cors"`
-		AllowedOrigins []string `toml:"allowed_origins"`
+		ListenAddr        string   `toml:"listen_addr"`
+		WriteTimeout      string   `toml:"write_timeout"`
+		ReadTimeout       string   `toml:"read_timeout"`
+		VerboseCORS       bool     `toml:"verbose_cors"`
+		AllowedOrigins    []string `toml:"allowed_origins"`
+		StalePriceTimeout string   `toml:"stale_price_timeout"`
+	}
+
+	// Candles defines the window of the candles over which the TVWAP is
+	// computed, and the age after which candles are discarded as stale.
+	Candles struct {
+		TVWAPWindow string `toml:"tvwap_window"`
+		MaxAge      string `toml:"max_age"`
 	}
 
 	// CurrencyPair defines a price quote of the exchange rate for two different
-	// currencies and the supported providers for getting the exchange rate.
+	// currencies and the supported providers for getting the exchange rate. The
+	// CandleFallback defines how a pair is priced by a provider whose candles are
+	// stale: with the VWAP of its tickers, or not at all.
 	CurrencyPair struct {
-		Base      string   `toml:"base" validate:"required"`
-		Quote     string   `toml:"quote" validate:"required"`
-		Providers []string `toml:"providers" validate:"required,gt=0,dive,required"`
+		Base           string   `toml:"base" validate:"required"`
+		Quote          string   `toml:"quote" validate:"required"`
+		Providers      []string `toml:"providers" validate:"required,gt=0,dive,required"`
+		CandleFallback string   `toml:"candle_fallback" validate:"omitempty,oneof=vwap abstain"`
+	}
+
+	// ProviderEndpoint defines an override of the REST and websocket URLs a
+	// provider connects to, e.g. to use a regional mirror or a local test
+	// server. An empty URL keeps the provider's default.
+	ProviderEndpoint struct {
+		Name      string `toml:"name" validate:"required"`
+		Rest      string `toml:"rest" validate:"omitempty,url"`
+		Websocket string `toml:"websocket" validate:"omitempty,url"`
+	}
+
+	// Deviation defines how the prices of a base deviating from the other
+	// providers are filtered out, and the minimum number of providers that must
+	// agree on a price for the base to be voted on. Bases without a Deviation use
+	// the sigma strategy with a threshold of 2.
+	Deviation struct {
+		Base         string `toml:"base" validate:"required"`
+		Strategy     string `toml:"strategy" validate:"omitempty,oneof=sigma mad percent"`
+		Threshold    string `toml:"threshold"`
+		MinProviders int    `toml:"min_providers" validate:"gte=0"`
 	}
 
 	// Account defines account related configuration that is related to the Umee
@@ -89,11 +150,52 @@ type (
 		Dir     string `toml:"dir" validate:"required"`
 	}
 
+	// Signer defines the backend signing the transactions of the feeder
+	// account. The keyring backend uses the key stored in the keyring, while
+	// the remote backend delegates signatures to a signing daemon at URL so
+	// that no key is stored on the price-feeder host.
+	Signer struct {
+		Type    string `toml:"type" validate:"omitempty,oneof=keyring remote"`
+		URL     string `toml:"url" validate:"required_if=Type remote,omitempty,url"`
+		Timeout string `toml:"timeout"`
+	}
+
+	// Tx defines the fees, gas and memo of the transactions broadcast by the
+	// feeder. A zero Gas simulates the gas of every transaction, adjusted by the
+	// gas adjustment, instead of using a fixed gas limit. The fees can be paid
+	// by the FeeGranter account through an x/feegrant allowance.
+	Tx struct {
+		GasPrices  string `toml:"gas_prices"`
+		Gas        uint64 `toml:"gas"`
+		FeeGranter string `toml:"fee_granter"`
+		Memo       string `toml:"memo" validate:"max=256"`
+	}
+
+	// Attestation defines the key signing the prices served to downstream
+	// services; the validator and chain ID are taken from the account section.
+	// KeyFile holds a hex encoded secp256k1 private key, and attestations are
+	// disabled if it is not set.
+	Attestation struct {
+		KeyFile string `toml:"key_file"`
+	}
+
 	// RPC defines RPC configuration of both the Umee gRPC and Tendermint nodes.
+	// The node of TMRPCEndpoint and GRPCEndpoint is followed by the fallback
+	// Nodes, whose health is checked every HealthCheckInterval so that the most
+	// up-to-date healthy node is used.
 	RPC struct {
+		TMRPCEndpoint       string    `toml:"tmrpc_endpoint" validate:"required_without=Nodes"`
+		GRPCEndpoint        string    `toml:"grpc_endpoint" validate:"required_with=TMRPCEndpoint"`
+		RPCTimeout          string    `toml:"rpc_timeout" validate:"required"`
+		HealthCheckInterval string    `toml:"health_check_interval"`
+		Nodes               []RPCNode `toml:"nodes" validate:"dive"`
+	}
+
+	// RPCNode defines the Tendermint RPC and Cosmos gRPC endpoints of an Umee
+	// node.
+	RPCNode struct {
 		TMRPCEndpoint string `toml:"tmrpc_endpoint" validate:"required"`
 		GRPCEndpoint  string `toml:"grpc_endpoint" validate:"required"`
-		RPCTimeout    string `toml:"rpc_timeout" validate:"required"`
 	}
 
 	// Telemetry defines the configuration options for application telemetry.
@@ -142,10 +244,58 @@ func telemetryValidation(sl validator.StructLevel) {
 	}
 }
 
-// Validate returns an error if the Config object is invalid.
+// Validate returns an error if the Config object is invalid. The account is not
+// required in dry-run mode, as no transactions are signed, unless prices are
+// attested for its validator. The keyring is only required if transactions are
+// not signed remotely.
 func (c Config) Validate() error {
 	validate.RegisterStructValidation(telemetryValidation, Telemetry{})
-	return validate.Struct(c)
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+
+	if c.DryRun {
+		if len(c.Attestation.KeyFile) != 0 {
+			return validate.Struct(c.Account)
+		}
+		return nil
+	}
+
+	if err := validate.Struct(c.Account); err != nil {
+		return err
+	}
+
+	if c.Signer.Type != SignerTypeRemote {
+		return validate.Struct(c.Keyring)
+	}
+
+	return nil
+}
+
+// NodeEndpoints returns the endpoints of all the configured Umee nodes, in order
+// of preference.
+func (rpc RPC) NodeEndpoints() []RPCNode {
+	if len(rpc.TMRPCEndpoint) == 0 {
+		return rpc.Nodes
+	}
+
+	primary := RPCNode{
+		TMRPCEndpoint: rpc.TMRPCEndpoint,
+		GRPCEndpoint:  rpc.GRPCEndpoint,
+	}
+
+	return append([]RPCNode{primary}, rpc.Nodes...)
+}
+
+// RestartRequired returns true if the given configuration differs from c in
+// any setting that cannot be applied to a running price-feeder, i.e. in
+// anything but the currency pairs, including their candle fallbacks, the
+// deviation thresholds and the provider endpoints.
+func (c Config) RestartRequired(cfg Config) bool {
+	cfg.CurrencyPairs = c.CurrencyPairs
+	cfg.Deviations = c.Deviations
+	cfg.ProviderEndpoints = c.ProviderEndpoints
+	return !reflect.DeepEqual(c, cfg)
 }
 
 // ParseConfig attempts to read and parse configuration from the given file path.
@@ -175,38 +325,164 @@ func ParseConfig(configPath string) (Config, error) {
 	if len(cfg.Server.ReadTimeout) == 0 {
 		cfg.Server.ReadTimeout = defaultSrvReadTimeout.String()
 	}
+	if len(cfg.Server.StalePriceTimeout) == 0 {
+		cfg.Server.StalePriceTimeout = defaultStalePriceTimeout.String()
+	}
+	if _, err := time.ParseDuration(cfg.Server.StalePriceTimeout); err != nil {
+		return cfg, fmt.Errorf("invalid stale price timeout: %w", err)
+	}
+	if len(cfg.Candles.TVWAPWindow) == 0 {
+		cfg.Candles.TVWAPWindow = defaultTVWAPWindow.String()
+	}
+	if d, err := time.ParseDuration(cfg.Candles.TVWAPWindow); err != nil || d <= 0 {
+		return cfg, fmt.Errorf("invalid tvwap window: %q", cfg.Candles.TVWAPWindow)
+	} else if d > provider.CandlePeriod {
+		return cfg, fmt.Errorf("tvwap window %s exceeds the %s of candles kept by providers", d, provider.CandlePeriod)
+	}
+	if len(cfg.Candles.MaxAge) == 0 {
+		cfg.Candles.MaxAge = defaultMaxCandleAge.String()
+	}
+	if d, err := time.ParseDuration(cfg.Candles.MaxAge); err != nil || d <= 0 {
+		return cfg, fmt.Errorf("invalid max candle age: %q", cfg.Candles.MaxAge)
+	}
+	if len(cfg.RPC.HealthCheckInterval) == 0 {
+		cfg.RPC.HealthCheckInterval = defaultHealthCheckInterval.String()
+	}
+	if len(cfg.Signer.Type) == 0 {
+		cfg.Signer.Type = SignerTypeKeyring
+	}
+	if len(cfg.Signer.Timeout) == 0 {
+		cfg.Signer.Timeout = defaultSignerTimeout.String()
+	}
 
 	pairs := make(map[string]map[string]struct{})
-	for _, cp := range cfg.CurrencyPairs {
-		if !strings.Contains(strings.ToUpper(cp.Quote), denomUSD) {
-			return cfg, fmt.Errorf("unsupported pair quote: %s", cp.Quote)
+	fallbacks := make(map[string]string)
+	for i, cp := range cfg.CurrencyPairs {
+		if len(cp.CandleFallback) == 0 {
+			cp.CandleFallback = CandleFallbackVWAP
+			cfg.CurrencyPairs[i] = cp
+		}
+
+		pair := types.CurrencyPair{Base: cp.Base, Quote: cp.Quote}.String()
+		if fallback, ok := fallbacks[pair]; ok && fallback != cp.CandleFallback {
+			return cfg, fmt.Errorf("conflicting candle fallbacks defined for pair: %s", pair)
 		}
+		fallbacks[pair] = cp.CandleFallback
+
 		if _, ok := pairs[cp.Base]; !ok {
 			pairs[cp.Base] = make(map[string]struct{})
 		}
 
-		for _, provider := range cp.Providers {
-			if _, ok := SupportedProviders[provider]; !ok {
-				return cfg, fmt.Errorf("unsupported provider: %s", provider)
+		for _, providerName := range cp.Providers {
+			if _, ok := provider.Lookup(providerName); !ok {
+				return cfg, fmt.Errorf("unsupported provider: %s", providerName)
 			}
-			pairs[cp.Base][provider] = struct{}{}
+			pairs[cp.Base][providerName] = struct{}{}
+		}
+	}
+
+	// every pair must be convertible to USD through the rates of other pairs
+	currencyPairs := make([]types.CurrencyPair, len(cfg.CurrencyPairs))
+	for i, cp := range cfg.CurrencyPairs {
+		currencyPairs[i] = types.CurrencyPair{Base: cp.Base, Quote: cp.Quote}
+	}
+	if _, err := types.ConversionOrder(currencyPairs); err != nil {
+		return cfg, err
+	}
+
+	deviations := make(map[string]Deviation, len(cfg.Deviations))
+	for i, d := range cfg.Deviations {
+		if _, ok := pairs[d.Base]; !ok {
+			return cfg, fmt.Errorf("deviation thresholds defined for unknown base: %s", d.Base)
+		}
+		if _, ok := deviations[d.Base]; ok {
+			return cfg, fmt.Errorf("duplicate deviation thresholds defined for base: %s", d.Base)
+		}
+
+		if len(d.Strategy) == 0 {
+			d.Strategy = DeviationStrategySigma
 		}
+		if len(d.Threshold) == 0 && d.Strategy == DeviationStrategySigma {
+			d.Threshold = defaultDeviationThreshold
+		}
+
+		threshold, err := sdk.NewDecFromStr(d.Threshold)
+		if err != nil || !threshold.IsPositive() {
+			return cfg, fmt.Errorf("invalid deviation threshold for %s: %q", d.Base, d.Threshold)
+		}
+
+		cfg.Deviations[i] = d
+		deviations[d.Base] = d
 	}
 
+	// the standard deviation is only meaningful with at least three providers,
+	// other strategies only need as many providers as must agree on a price
 	for base, providers := range pairs {
-		if _, ok := pairs[base]["mock"]; !ok && len(providers) < 3 {
-			return cfg, fmt.Errorf("must have at least three providers for %s", base)
+		if _, ok := pairs[base][provider.ProviderMock]; ok {
+			continue
+		}
+
+		d, ok := deviations[base]
+		if !ok || d.Strategy == DeviationStrategySigma {
+			if len(providers) < 3 {
+				return cfg, fmt.Errorf("must have at least three providers for %s", base)
+			}
+		}
+		if ok && d.MinProviders > len(providers) {
+			return cfg, fmt.Errorf(
+				"must have at least %d providers for %s to agree on its price", d.MinProviders, base,
+			)
 		}
 	}
 
-	gatePairs := []string{}
+	endpoints := make(map[string]struct{}, len(cfg.ProviderEndpoints))
+	for _, e := range cfg.ProviderEndpoints {
+		if _, ok := provider.Lookup(e.Name); !ok {
+			return cfg, fmt.Errorf("endpoints defined for unsupported provider: %s", e.Name)
+		}
+		if _, ok := endpoints[e.Name]; ok {
+			return cfg, fmt.Errorf("duplicate endpoints defined for provider: %s", e.Name)
+		}
+		endpoints[e.Name] = struct{}{}
+	}
+
+	providerPairs := make(map[string][]string)
 	for base, providers := range pairs {
-		if _, ok := providers["gate"]; ok {
-			gatePairs = append(gatePairs, base)
+		for providerName := range providers {
+			providerPairs[providerName] = append(providerPairs[providerName], base)
+		}
+	}
+	for providerName, bases := range providerPairs {
+		r, _ := provider.Lookup(providerName)
+		if maxPairs := r.Capabilities.MaxPairs; maxPairs > 0 && len(bases) > maxPairs {
+			sort.Strings(bases)
+			return cfg, fmt.Errorf(
+				"%s provider does not support more than %d pairs: %v", providerName, maxPairs, bases,
+			)
+		}
+	}
+
+	nodes := make(map[string]struct{})
+	for _, n := range cfg.RPC.NodeEndpoints() {
+		if _, ok := nodes[n.TMRPCEndpoint]; ok {
+			return cfg, fmt.Errorf("duplicate rpc node: %s", n.TMRPCEndpoint)
 		}
+		nodes[n.TMRPCEndpoint] = struct{}{}
 	}
-	if len(gatePairs) > 1 {
-		return cfg, fmt.Errorf("gate provider does not support multiple pairs: %v", gatePairs)
+
+	if len(cfg.Tx.GasPrices) != 0 {
+		if _, err := sdk.ParseDecCoins(cfg.Tx.GasPrices); err != nil {
+			return cfg, fmt.Errorf("invalid gas prices: %w", err)
+		}
+	}
+
+	// oracle txs are only exempt from fees if they do not use more gas than
+	// MaxOracleMsgGasUsage per message, and the feeder sends one message per tx
+	if cfg.Tx.Gas > ante.MaxOracleMsgGasUsage {
+		return cfg, fmt.Errorf(
+			"gas %d exceeds the maximum gas of oracle txs exempt from fees: %d",
+			cfg.Tx.Gas, ante.MaxOracleMsgGasUsage,
+		)
 	}
 
 	return cfg, cfg.Validate()
@@ -148,6 +148,15 @@ providers = [
 	"huobi"
 ]
 
+[[currency_pairs]]
+base = "USDT"
+quote = "USD"
+providers = [
+	"kraken",
+	"coinbase",
+	"bitfinex"
+]
+
 [account]
 address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
 validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
@@ -182,7 +191,7 @@ global_labels = [["chain-id", "umee-local-testnet"]]
 	require.Equal(t, "20s", cfg.Server.WriteTimeout)
 	require.Equal(t, "20s", cfg.Server.ReadTimeout)
 	require.True(t, cfg.Server.VerboseCORS)
-	require.Len(t, cfg.CurrencyPairs, 2)
+	require.Len(t, cfg.CurrencyPairs, 3)
 	require.Equal(t, "ATOM", cfg.CurrencyPairs[0].Base)
 	require.Equal(t, "USDT", cfg.CurrencyPairs[0].Quote)
 	require.Len(t, cfg.CurrencyPairs[0].Providers, 3)
@@ -222,6 +231,15 @@ providers = [
 	"huobi"
 ]
 
+[[currency_pairs]]
+base = "USDT"
+quote = "USD"
+providers = [
+	"kraken",
+	"coinbase",
+	"bitfinex"
+]
+
 [account]
 address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
 validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
@@ -250,7 +268,7 @@ enabled = false
 	require.Equal(t, "20s", cfg.Server.WriteTimeout)
 	require.Equal(t, "20s", cfg.Server.ReadTimeout)
 	require.True(t, cfg.Server.VerboseCORS)
-	require.Len(t, cfg.CurrencyPairs, 2)
+	require.Len(t, cfg.CurrencyPairs, 3)
 	require.Equal(t, "ATOM", cfg.CurrencyPairs[0].Base)
 	require.Equal(t, "USDT", cfg.CurrencyPairs[0].Quote)
 	require.Len(t, cfg.CurrencyPairs[0].Providers, 3)
@@ -289,3 +307,901 @@ providers = [
 	_, err = config.ParseConfig(tmpFile.Name())
 	require.Error(t, err)
 }
+
+func TestParseConfig_UnconvertibleQuote(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "STATOM"
+quote = "ATOM"
+providers = [
+	"mock"
+]
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USDT"
+providers = [
+	"kraken",
+	"binance",
+	"huobi"
+]
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.EqualError(t, err, "unable to convert ATOM to USD: no currency pair with base USDT")
+}
+
+func TestParseConfig_ProviderMaxPairs(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"gate"
+]
+
+[[currency_pairs]]
+base = "UMEE"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"gate"
+]
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.EqualError(t, err, "gate provider does not support more than 1 pairs: [ATOM UMEE]")
+}
+
+func TestParseConfig_ProviderEndpoints(t *testing.T) {
+	testCases := []struct {
+		name      string
+		endpoints string
+		expectErr bool
+	}{
+		{
+			"valid endpoints",
+			`
+[[provider_endpoints]]
+name = "binance"
+websocket = "ws://localhost:9443/ws"
+
+[[provider_endpoints]]
+name = "osmosis"
+rest = "https://osmosis-mirror.example.com"
+`,
+			false,
+		},
+		{
+			"unsupported provider",
+			`
+[[provider_endpoints]]
+name = "foobar"
+rest = "https://foobar.example.com"
+`,
+			true,
+		},
+		{
+			"duplicate provider",
+			`
+[[provider_endpoints]]
+name = "binance"
+websocket = "ws://localhost:9443/ws"
+
+[[provider_endpoints]]
+name = "binance"
+websocket = "ws://localhost:9444/ws"
+`,
+			true,
+		},
+		{
+			"invalid url",
+			`
+[[provider_endpoints]]
+name = "osmosis"
+rest = "not a url"
+`,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+			require.NoError(t, err)
+			defer os.Remove(tmpFile.Name())
+
+			content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+` + tc.endpoints)
+			_, err = tmpFile.Write(content)
+			require.NoError(t, err)
+
+			cfg, err := config.ParseConfig(tmpFile.Name())
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, cfg.ProviderEndpoints, 2)
+			require.Equal(t, "ws://localhost:9443/ws", cfg.ProviderEndpoints[0].Websocket)
+			require.Equal(t, "https://osmosis-mirror.example.com", cfg.ProviderEndpoints[1].Rest)
+		})
+	}
+}
+
+func TestParseConfig_Deviations(t *testing.T) {
+	testCases := []struct {
+		name       string
+		providers  string
+		deviations string
+		expectErr  bool
+	}{
+		{
+			"default strategy",
+			`"kraken", "binance", "osmosis"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+min_providers = 2
+`,
+			false,
+		},
+		{
+			"two providers with percent strategy",
+			`"kraken", "binance"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+strategy = "percent"
+threshold = "2.5"
+min_providers = 2
+`,
+			false,
+		},
+		{
+			"two providers with sigma strategy",
+			`"kraken", "binance"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+strategy = "sigma"
+threshold = "3"
+`,
+			true,
+		},
+		{
+			"unsupported strategy",
+			`"kraken", "binance", "osmosis"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+strategy = "foobar"
+threshold = "3"
+`,
+			true,
+		},
+		{
+			"missing threshold",
+			`"kraken", "binance", "osmosis"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+strategy = "mad"
+`,
+			true,
+		},
+		{
+			"negative threshold",
+			`"kraken", "binance", "osmosis"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+strategy = "mad"
+threshold = "-3"
+`,
+			true,
+		},
+		{
+			"unknown base",
+			`"kraken", "binance", "osmosis"`,
+			`
+[[deviation_thresholds]]
+base = "UMEE"
+`,
+			true,
+		},
+		{
+			"duplicate base",
+			`"kraken", "binance", "osmosis"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+
+[[deviation_thresholds]]
+base = "ATOM"
+`,
+			true,
+		},
+		{
+			"too many agreeing providers",
+			`"kraken", "binance", "osmosis"`,
+			`
+[[deviation_thresholds]]
+base = "ATOM"
+min_providers = 4
+`,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+			require.NoError(t, err)
+			defer os.Remove(tmpFile.Name())
+
+			content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [` + tc.providers + `]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+` + tc.deviations)
+			_, err = tmpFile.Write(content)
+			require.NoError(t, err)
+
+			cfg, err := config.ParseConfig(tmpFile.Name())
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, cfg.Deviations, 1)
+			require.NotEmpty(t, cfg.Deviations[0].Strategy)
+			require.NotEmpty(t, cfg.Deviations[0].Threshold)
+			require.Equal(t, 2, cfg.Deviations[0].MinProviders)
+		})
+	}
+}
+
+func TestParseConfig_Candles(t *testing.T) {
+	testCases := []struct {
+		name      string
+		candles   string
+		expectErr bool
+	}{
+		{
+			"defaults",
+			`
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+`,
+			false,
+		},
+		{
+			"window, max age and fallbacks",
+			`
+[candles]
+tvwap_window = "5m"
+max_age = "2m"
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+candle_fallback = "abstain"
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"coinbase",
+]
+candle_fallback = "abstain"
+`,
+			false,
+		},
+		{
+			"invalid tvwap window",
+			`
+[candles]
+tvwap_window = "5"
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+`,
+			true,
+		},
+		{
+			"tvwap window above the candle period",
+			`
+[candles]
+tvwap_window = "15m"
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+`,
+			true,
+		},
+		{
+			"zero max age",
+			`
+[candles]
+max_age = "0s"
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+`,
+			true,
+		},
+		{
+			"invalid fallback",
+			`
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+candle_fallback = "twap"
+`,
+			true,
+		},
+		{
+			"conflicting fallbacks",
+			`
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+candle_fallback = "abstain"
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"coinbase",
+]
+`,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+			require.NoError(t, err)
+			defer os.Remove(tmpFile.Name())
+
+			content := []byte(`
+gas_adjustment = 1.5
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+` + tc.candles)
+			_, err = tmpFile.Write(content)
+			require.NoError(t, err)
+
+			cfg, err := config.ParseConfig(tmpFile.Name())
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, cfg.Candles.TVWAPWindow)
+			require.NotEmpty(t, cfg.Candles.MaxAge)
+			for _, cp := range cfg.CurrencyPairs {
+				require.NotEmpty(t, cp.CandleFallback)
+			}
+		})
+	}
+}
+
+func TestParseConfig_Signer(t *testing.T) {
+	testCases := []struct {
+		name      string
+		signer    string
+		expectErr bool
+	}{
+		{
+			"keyring signer",
+			`
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+`,
+			false,
+		},
+		{
+			"missing keyring",
+			`
+[signer]
+type = "keyring"
+`,
+			true,
+		},
+		{
+			"remote signer",
+			`
+[signer]
+type = "remote"
+url = "https://signer.example.com"
+timeout = "2s"
+`,
+			false,
+		},
+		{
+			"remote signer without url",
+			`
+[signer]
+type = "remote"
+`,
+			true,
+		},
+		{
+			"unsupported signer",
+			`
+[signer]
+type = "foobar"
+url = "https://signer.example.com"
+`,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+			require.NoError(t, err)
+			defer os.Remove(tmpFile.Name())
+
+			content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+` + tc.signer)
+			_, err = tmpFile.Write(content)
+			require.NoError(t, err)
+
+			cfg, err := config.ParseConfig(tmpFile.Name())
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, cfg.Signer.Type)
+			require.NotEmpty(t, cfg.Signer.Timeout)
+		})
+	}
+}
+
+func TestParseConfig_Tx(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tx        string
+		expectErr bool
+	}{
+		{
+			"simulated gas",
+			`
+[tx]
+gas_prices = "0.00025uumee"
+memo = "price-feeder"
+`,
+			false,
+		},
+		{
+			"fixed gas",
+			`
+[tx]
+gas = 80000
+fee_granter = "umee1y6xz2ggfc0pcsmyjlekh0j9pxh6hk87ymc9due"
+`,
+			false,
+		},
+		{
+			"gas exceeding the fee bypass",
+			`
+[tx]
+gas = 200000
+`,
+			true,
+		},
+		{
+			"invalid gas prices",
+			`
+[tx]
+gas_prices = "uumee"
+`,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+			require.NoError(t, err)
+			defer os.Remove(tmpFile.Name())
+
+			content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+` + tc.tx)
+			_, err = tmpFile.Write(content)
+			require.NoError(t, err)
+
+			_, err = config.ParseConfig(tmpFile.Name())
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseConfig_RPCNodes(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rpc       string
+		expected  []config.RPCNode
+		expectErr bool
+	}{
+		{
+			"single node",
+			`
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+`,
+			[]config.RPCNode{
+				{TMRPCEndpoint: "http://localhost:26657", GRPCEndpoint: "localhost:9090"},
+			},
+			false,
+		},
+		{
+			"fallback nodes",
+			`
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+
+[[rpc.nodes]]
+tmrpc_endpoint = "http://node1:26657"
+grpc_endpoint = "node1:9090"
+`,
+			[]config.RPCNode{
+				{TMRPCEndpoint: "http://localhost:26657", GRPCEndpoint: "localhost:9090"},
+				{TMRPCEndpoint: "http://node1:26657", GRPCEndpoint: "node1:9090"},
+			},
+			false,
+		},
+		{
+			"only fallback nodes",
+			`
+[rpc]
+rpc_timeout = "100ms"
+
+[[rpc.nodes]]
+tmrpc_endpoint = "http://node1:26657"
+grpc_endpoint = "node1:9090"
+`,
+			[]config.RPCNode{
+				{TMRPCEndpoint: "http://node1:26657", GRPCEndpoint: "node1:9090"},
+			},
+			false,
+		},
+		{
+			"no nodes",
+			`
+[rpc]
+rpc_timeout = "100ms"
+`,
+			nil,
+			true,
+		},
+		{
+			"fallback node without grpc endpoint",
+			`
+[rpc]
+rpc_timeout = "100ms"
+
+[[rpc.nodes]]
+tmrpc_endpoint = "http://node1:26657"
+`,
+			nil,
+			true,
+		},
+		{
+			"duplicate nodes",
+			`
+[rpc]
+tmrpc_endpoint = "http://node1:26657"
+grpc_endpoint = "node1:9090"
+rpc_timeout = "100ms"
+
+[[rpc.nodes]]
+tmrpc_endpoint = "http://node1:26657"
+grpc_endpoint = "node1:9090"
+`,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+			require.NoError(t, err)
+			defer os.Remove(tmpFile.Name())
+
+			content := []byte(`
+gas_adjustment = 1.5
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+
+[account]
+address = "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4"
+validator = "umeevalcons14rjlkfzp56733j5l5nfk6fphjxymgf8mj04d5p"
+chain_id = "umee-local-testnet"
+
+[keyring]
+backend = "test"
+dir = "/Users/username/.umee"
+` + tc.rpc)
+			_, err = tmpFile.Write(content)
+			require.NoError(t, err)
+
+			cfg, err := config.ParseConfig(tmpFile.Name())
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, cfg.RPC.NodeEndpoints())
+			require.NotEmpty(t, cfg.RPC.HealthCheckInterval)
+		})
+	}
+}
+
+func TestParseConfig_DryRun(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+dry_run = true
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"kraken",
+	"binance",
+	"osmosis"
+]
+
+[rpc]
+tmrpc_endpoint = "http://localhost:26657"
+grpc_endpoint = "localhost:9090"
+rpc_timeout = "100ms"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	// neither the account nor the keyring are needed in dry-run mode
+	cfg, err := config.ParseConfig(tmpFile.Name())
+	require.NoError(t, err)
+	require.True(t, cfg.DryRun)
+
+	cfg.DryRun = false
+	require.Error(t, cfg.Validate())
+
+	// the account is needed to attest prices for its validator
+	cfg.DryRun = true
+	cfg.Attestation.KeyFile = "/Users/username/.price-feeder/attestation.key"
+	require.Error(t, cfg.Validate())
+
+	cfg.Account = config.Account{
+		ChainID:   "umee-local-testnet",
+		Address:   "umee15nejfgcaanqpw25ru4arvfd0fwy6j8clccvwx4",
+		Validator: "umeevaloper12tysz6mzrawenca2t3t7ltym4hfjj8a5upsn2k",
+	}
+	require.NoError(t, cfg.Validate())
+}
+
+func TestConfig_RestartRequired(t *testing.T) {
+	cfg := config.Config{
+		CurrencyPairs: []config.CurrencyPair{
+			{Base: "ATOM", Quote: "USDT", Providers: []string{"kraken"}},
+		},
+		RPC: config.RPC{
+			TMRPCEndpoint: "http://localhost:26657",
+			GRPCEndpoint:  "localhost:9090",
+			RPCTimeout:    "100ms",
+		},
+	}
+
+	newCfg := cfg
+	newCfg.CurrencyPairs = []config.CurrencyPair{
+		{Base: "UMEE", Quote: "USDT", Providers: []string{"binance"}},
+	}
+	require.False(t, cfg.RestartRequired(newCfg))
+
+	newCfg.Deviations = []config.Deviation{{Base: "UMEE", Threshold: "1.5"}}
+	newCfg.ProviderEndpoints = []config.ProviderEndpoint{
+		{Name: "binance", Rest: "https://api1.binance.com", Websocket: "stream.binance.com:9443"},
+	}
+	require.False(t, cfg.RestartRequired(newCfg))
+
+	newCfg.RPC.GRPCEndpoint = "localhost:9091"
+	require.True(t, cfg.RestartRequired(newCfg))
+}
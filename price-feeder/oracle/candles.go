@@ -0,0 +1,59 @@
+package oracle
+
+import (
+	"github.com/umee-network/umee/price-feeder/config"
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+// discardStaleCandles discards the candles older than the maximum candle age,
+// as websocket providers can keep serving the candles of a feed that silently
+// stopped. A provider pair left without candles is priced with the VWAP of its
+// tickers, unless its candle fallback is to abstain, in which case its ticker
+// is discarded as well.
+func (o *Oracle) discardStaleCandles(
+	prices pairProviderPrices,
+	candles pairProviderCandles,
+	candleFallbacks map[types.CurrencyPair]string,
+) {
+	minTimeStamp := provider.PastUnixTime(o.maxCandleAge)
+
+	for providerName, providerCandles := range candles {
+		for pair, cs := range providerCandles {
+			var (
+				fresh  []provider.CandlePrice
+				latest int64
+			)
+			for _, c := range cs {
+				if c.TimeStamp >= minTimeStamp {
+					fresh = append(fresh, c)
+				}
+				if c.TimeStamp > latest {
+					latest = c.TimeStamp
+				}
+			}
+
+			if len(fresh) > 0 {
+				providerCandles[pair] = fresh
+				continue
+			}
+
+			delete(providerCandles, pair)
+
+			fallback, ok := candleFallbacks[pair]
+			if !ok {
+				fallback = config.CandleFallbackVWAP
+			}
+			if fallback == config.CandleFallbackAbstain {
+				delete(prices[providerName], pair)
+			}
+
+			o.logger.Warn().
+				Str("provider", providerName).
+				Str("pair", pair.String()).
+				Int64("latest_candle", latest).
+				Str("fallback", fallback).
+				Msg("discarding stale candles")
+		}
+	}
+}
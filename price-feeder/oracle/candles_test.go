@@ -0,0 +1,145 @@
+package oracle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/config"
+	"github.com/umee-network/umee/price-feeder/oracle/client"
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+// stalledProvider serves the tickers and candles of a feed that stopped an
+// hour ago.
+type stalledProvider struct {
+	prices map[string]provider.TickerPrice
+}
+
+func (m stalledProvider) GetTickerPrices(_ ...types.CurrencyPair) (map[string]provider.TickerPrice, error) {
+	return m.prices, nil
+}
+
+func (m stalledProvider) GetCandlePrices(_ ...types.CurrencyPair) (map[string][]provider.CandlePrice, error) {
+	candles := make(map[string][]provider.CandlePrice)
+	for pair, price := range m.prices {
+		candles[pair] = []provider.CandlePrice{
+			{
+				Price:     price.Price,
+				TimeStamp: provider.PastUnixTime(time.Hour),
+				Volume:    price.Volume,
+			},
+		}
+	}
+	return candles, nil
+}
+
+func (m stalledProvider) SubscribeCurrencyPairs(_ ...types.CurrencyPair) error {
+	return nil
+}
+
+func TestSetPrices_StaleCandles(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:           "ATOM",
+					Quote:          "USD",
+					Providers:      []string{provider.ProviderKraken},
+					CandleFallback: config.CandleFallbackVWAP,
+				},
+				{
+					Base:           "UMEE",
+					Quote:          "USD",
+					Providers:      []string{provider.ProviderKraken},
+					CandleFallback: config.CandleFallbackAbstain,
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderKraken: stalledProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr("10"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+				"UMEEUSD": {
+					Price:  sdk.MustNewDecFromStr("0.05"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+	}
+
+	acceptList := oracletypes.DenomList{
+		oracletypes.Denom{BaseDenom: "UATOM", SymbolDenom: "ATOM", Exponent: 6},
+		oracletypes.Denom{BaseDenom: "UUMEE", SymbolDenom: "UMEE", Exponent: 6},
+	}
+
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+
+	// ATOM falls back to the VWAP of its ticker, while UMEE is abstained from
+	prices := oracle.GetPrices()
+	require.Len(t, prices, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), prices["ATOM"])
+
+	report := oracle.GetPriceReport()
+	require.Empty(t, report.Candles[provider.ProviderKraken])
+	require.NotContains(t, report.Tickers[provider.ProviderKraken], "UMEE")
+
+	health := oracle.GetProviderHealth()
+	require.Equal(t, 0, health[provider.ProviderKraken]["ATOMUSD"].ConsecutiveFailures)
+	require.Equal(t, 1, health[provider.ProviderKraken]["UMEEUSD"].ConsecutiveFailures)
+}
+
+func TestSetPrices_FreshCandles(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:           "UMEE",
+					Quote:          "USD",
+					Providers:      []string{provider.ProviderKraken},
+					CandleFallback: config.CandleFallbackAbstain,
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: 2 * time.Hour,
+		},
+	)
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderKraken: stalledProvider{
+			prices: map[string]provider.TickerPrice{
+				"UMEEUSD": {
+					Price:  sdk.MustNewDecFromStr("0.05"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+	}
+
+	acceptList := oracletypes.DenomList{
+		oracletypes.Denom{BaseDenom: "UUMEE", SymbolDenom: "UMEE", Exponent: 6},
+	}
+
+	// the candles are not stale, but are out of the TVWAP window, so the VWAP of
+	// the ticker is used
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), oracle.GetPrices()["UMEE"])
+	require.Len(t, oracle.GetPriceReport().Candles[provider.ProviderKraken]["UMEE"], 1)
+}
@@ -0,0 +1,336 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	chainHeightSubscriber = "price-feeder"
+
+	// resubscribeBackoff defines the delay before subscribing again to the
+	// NewBlock events of a node whose subscription was closed. It is doubled
+	// after every failed attempt, up to maxResubscribeBackoff.
+	resubscribeBackoff    = 1 * time.Second
+	maxResubscribeBackoff = 1 * time.Minute
+
+	// pollInterval defines the interval at which the chain height is polled
+	// while the subscriptions of all nodes are closed.
+	pollInterval = 1 * time.Second
+)
+
+var (
+	errParseEventDataNewBlock = errors.New("failed to parse EventDataNewBlock")
+	errNewBlockSubscription   = errors.New("NewBlock subscription closed")
+)
+
+// ChainHeight tracks the height of the chain from the NewBlock events received
+// over the Tendermint RPC websockets of the nodes, so that callers neither have
+// to poll a node for its height nor miss the start of a block. Events of every
+// node are received, so that a stalled node does not delay new blocks. Closed
+// subscriptions are subscribed again with backoff, and the height is polled
+// meanwhile once the subscriptions of all nodes are closed.
+type ChainHeight struct {
+	Logger zerolog.Logger
+
+	resubscribeBackoff time.Duration
+	pollHeight         func(ctx context.Context) (int64, error)
+	pollInterval       time.Duration
+
+	mtx           sync.RWMutex
+	lastHeight    int64
+	lastErr       error
+	newBlock      chan struct{}
+	subscriptions int
+	polling       bool
+}
+
+// NewChainHeight returns a new ChainHeight initialized with the latest height
+// of the nodes, and subscribes to the NewBlock events of every node until ctx
+// is canceled. The RPC clients are started if they are not running yet. Nodes
+// that cannot be subscribed to are skipped, and an error is only returned if
+// none of them can. While no subscription is open, the height is polled with
+// pollHeight, e.g. from the node currently in use.
+func NewChainHeight(
+	ctx context.Context,
+	rpcClients []tmrpcclient.Client,
+	pollHeight func(ctx context.Context) (int64, error),
+	logger zerolog.Logger,
+) (*ChainHeight, error) {
+	chainHeight := &ChainHeight{
+		Logger:             logger.With().Str("module", "chain_height").Logger(),
+		resubscribeBackoff: resubscribeBackoff,
+		pollHeight:         pollHeight,
+		pollInterval:       pollInterval,
+		newBlock:           make(chan struct{}),
+	}
+
+	var (
+		eventChs []<-chan tmctypes.ResultEvent
+		clients  []tmrpcclient.Client
+		lastErr  error
+	)
+	for _, rpcClient := range rpcClients {
+		eventsCh, height, err := chainHeight.subscribeNode(ctx, rpcClient)
+		if err != nil {
+			chainHeight.Logger.Warn().Err(err).Msg("failed to subscribe to NewBlock events")
+			lastErr = err
+			continue
+		}
+
+		if height > chainHeight.lastHeight {
+			chainHeight.lastHeight = height
+		}
+
+		eventChs = append(eventChs, eventsCh)
+		clients = append(clients, rpcClient)
+	}
+
+	if len(eventChs) == 0 {
+		if lastErr == nil {
+			lastErr = errNoNodes
+		}
+		return nil, lastErr
+	}
+
+	chainHeight.subscriptions = len(eventChs)
+	for i := range eventChs {
+		go chainHeight.subscribe(ctx, clients[i], eventChs[i])
+	}
+
+	return chainHeight, nil
+}
+
+// subscribeNode subscribes to the NewBlock events of the node of the given
+// client, and returns its latest height.
+func (ch *ChainHeight) subscribeNode(
+	ctx context.Context,
+	rpcClient tmrpcclient.Client,
+) (<-chan tmctypes.ResultEvent, int64, error) {
+	if !rpcClient.IsRunning() {
+		if err := rpcClient.Start(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	status, err := rpcClient.Status(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	eventsCh, err := rpcClient.Subscribe(ctx, chainHeightSubscriber, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return eventsCh, status.SyncInfo.LatestBlockHeight, nil
+}
+
+// GetChainHeight returns the height of the latest block received, or the error
+// that prevented receiving new blocks.
+func (ch *ChainHeight) GetChainHeight() (int64, error) {
+	ch.mtx.RLock()
+	defer ch.mtx.RUnlock()
+
+	return ch.lastHeight, ch.lastErr
+}
+
+// WaitForNewBlock blocks until a block higher than the given height is
+// received, and returns the height of the latest block.
+func (ch *ChainHeight) WaitForNewBlock(ctx context.Context, height int64) (int64, error) {
+	for {
+		ch.mtx.RLock()
+		lastHeight, lastErr, newBlock := ch.lastHeight, ch.lastErr, ch.newBlock
+		ch.mtx.RUnlock()
+
+		if lastErr != nil {
+			return 0, lastErr
+		}
+		if lastHeight > height {
+			return lastHeight, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+
+		case <-newBlock:
+		}
+	}
+}
+
+// subscribe updates the height on every NewBlock event of a node until ctx is
+// canceled. The node is subscribed to again if its subscription is closed.
+func (ch *ChainHeight) subscribe(
+	ctx context.Context,
+	rpcClient tmrpcclient.Client,
+	eventsCh <-chan tmctypes.ResultEvent,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			if err := rpcClient.Unsubscribe(
+				context.Background(),
+				chainHeightSubscriber,
+				tmtypes.EventQueryNewBlock.String(),
+			); err != nil {
+				ch.Logger.Err(err).Msg("failed to unsubscribe from NewBlock events")
+			}
+			ch.update(0, ctx.Err())
+			return
+
+		case resultEvent, ok := <-eventsCh:
+			if !ok {
+				ch.Logger.Error().Msg("NewBlock subscription closed")
+				ch.closeSubscription(ctx)
+
+				eventsCh = ch.resubscribe(ctx, rpcClient)
+				if eventsCh == nil {
+					ch.update(0, ctx.Err())
+					return
+				}
+				continue
+			}
+
+			eventDataNewBlock, ok := resultEvent.Data.(tmtypes.EventDataNewBlock)
+			if !ok || eventDataNewBlock.Block == nil {
+				ch.Logger.Err(errParseEventDataNewBlock).Msg("failed to parse NewBlock event")
+				continue
+			}
+
+			ch.update(eventDataNewBlock.Block.Height, nil)
+		}
+	}
+}
+
+// resubscribe subscribes again to the NewBlock events of a node, doubling the
+// delay between attempts, until it succeeds or ctx is canceled, in which case
+// nil is returned.
+func (ch *ChainHeight) resubscribe(ctx context.Context, rpcClient tmrpcclient.Client) <-chan tmctypes.ResultEvent {
+	backoff := ch.resubscribeBackoff
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-time.After(backoff):
+		}
+
+		// the closed subscription must be removed before subscribing again
+		_ = rpcClient.Unsubscribe(ctx, chainHeightSubscriber, tmtypes.EventQueryNewBlock.String())
+
+		eventsCh, height, err := ch.subscribeNode(ctx, rpcClient)
+		if err == nil {
+			ch.Logger.Info().Msg("resubscribed to NewBlock events")
+			ch.openSubscription(height)
+			return eventsCh
+		}
+
+		ch.Logger.Warn().Err(err).Dur("backoff", backoff).Msg("failed to resubscribe to NewBlock events")
+
+		backoff *= 2
+		if backoff > maxResubscribeBackoff {
+			backoff = maxResubscribeBackoff
+		}
+	}
+}
+
+// openSubscription reports a new subscription of a node at the given height,
+// which allows receiving new blocks again.
+func (ch *ChainHeight) openSubscription(height int64) {
+	ch.mtx.Lock()
+	defer ch.mtx.Unlock()
+
+	ch.subscriptions++
+	ch.lastErr = nil
+	if height > ch.lastHeight {
+		ch.lastHeight = height
+	}
+
+	close(ch.newBlock)
+	ch.newBlock = make(chan struct{})
+}
+
+// closeSubscription reports the closing of the subscription of a node. Once the
+// subscriptions of all nodes are closed, the height is polled until one of them
+// is open again, or new blocks can no longer be received if it can't be polled.
+func (ch *ChainHeight) closeSubscription(ctx context.Context) {
+	ch.mtx.Lock()
+	ch.subscriptions--
+	closed := ch.subscriptions <= 0
+	startPolling := closed && ch.pollHeight != nil && !ch.polling
+	if startPolling {
+		ch.polling = true
+	}
+	ch.mtx.Unlock()
+
+	switch {
+	case startPolling:
+		ch.Logger.Warn().Msg("all NewBlock subscriptions closed; polling the chain height")
+		go ch.poll(ctx)
+
+	case closed && ch.pollHeight == nil:
+		ch.update(0, errNewBlockSubscription)
+	}
+}
+
+// poll updates the height from pollHeight every pollInterval, until a
+// subscription is open again or ctx is canceled. Polling errors are reported
+// to the callers until the height can be polled again.
+func (ch *ChainHeight) poll(ctx context.Context) {
+	for {
+		ch.mtx.Lock()
+		if ch.subscriptions > 0 || ctx.Err() != nil {
+			ch.polling = false
+			ch.mtx.Unlock()
+			return
+		}
+		ch.mtx.Unlock()
+
+		height, err := ch.pollHeight(ctx)
+		if err != nil {
+			ch.Logger.Err(err).Msg("failed to poll the chain height")
+			ch.update(0, fmt.Errorf("%w: %s", errNewBlockSubscription, err))
+		} else {
+			ch.update(height, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(ch.pollInterval):
+		}
+	}
+}
+
+// update sets the latest height, or the error that prevents receiving new
+// blocks, and wakes up the callers waiting for a new block. Heights that are
+// not higher than the latest one, e.g. received from a lagging node, are
+// ignored unless they clear an error.
+func (ch *ChainHeight) update(height int64, err error) {
+	ch.mtx.Lock()
+	defer ch.mtx.Unlock()
+
+	if err == nil && height <= ch.lastHeight && ch.lastErr == nil {
+		return
+	}
+
+	if err != nil {
+		ch.lastErr = err
+	} else {
+		if height > ch.lastHeight {
+			ch.lastHeight = height
+		}
+		ch.lastErr = nil
+	}
+
+	close(ch.newBlock)
+	ch.newBlock = make(chan struct{})
+}
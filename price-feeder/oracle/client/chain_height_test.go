@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// resubscribingClient defines a Tendermint RPC client whose NewBlock
+// subscriptions fail a given number of times, and are otherwise sent on subs.
+type resubscribingClient struct {
+	tmrpcclient.Client
+
+	height   int64
+	failures int
+	subs     chan chan tmctypes.ResultEvent
+}
+
+func (c *resubscribingClient) IsRunning() bool { return true }
+
+func (c *resubscribingClient) Status(context.Context) (*tmctypes.ResultStatus, error) {
+	return &tmctypes.ResultStatus{SyncInfo: tmctypes.SyncInfo{LatestBlockHeight: c.height}}, nil
+}
+
+func (c *resubscribingClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (c *resubscribingClient) Subscribe(
+	context.Context,
+	string,
+	string,
+	...int,
+) (<-chan tmctypes.ResultEvent, error) {
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("connection refused")
+	}
+
+	eventsCh := make(chan tmctypes.ResultEvent)
+	c.subs <- eventsCh
+
+	return eventsCh, nil
+}
+
+func newBlockEvent(height int64) tmctypes.ResultEvent {
+	return tmctypes.ResultEvent{
+		Data: tmtypes.EventDataNewBlock{
+			Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}},
+		},
+	}
+}
+
+func TestChainHeight_WaitForNewBlock(t *testing.T) {
+	chainHeight := &ChainHeight{
+		Logger:     zerolog.Nop(),
+		lastHeight: 10,
+		newBlock:   make(chan struct{}),
+	}
+
+	// blocks higher than the given height were already received
+	height, err := chainHeight.WaitForNewBlock(context.Background(), 9)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		chainHeight.update(11, nil)
+	}()
+
+	height, err = chainHeight.WaitForNewBlock(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+
+	height, err = chainHeight.GetChainHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = chainHeight.WaitForNewBlock(ctx, 11)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// waiting callers are woken up by subscription errors
+	subscriptionErr := errors.New("subscription closed")
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		chainHeight.update(0, subscriptionErr)
+	}()
+
+	_, err = chainHeight.WaitForNewBlock(context.Background(), 11)
+	require.ErrorIs(t, err, subscriptionErr)
+
+	height, err = chainHeight.GetChainHeight()
+	require.ErrorIs(t, err, subscriptionErr)
+	require.Equal(t, int64(11), height)
+}
+
+func TestChainHeight_Resubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rpcClient := &resubscribingClient{
+		height:   12,
+		failures: 2,
+		subs:     make(chan chan tmctypes.ResultEvent, 1),
+	}
+	chainHeight := &ChainHeight{
+		Logger:             zerolog.Nop(),
+		resubscribeBackoff: time.Millisecond,
+		lastHeight:         10,
+		newBlock:           make(chan struct{}),
+		subscriptions:      1,
+	}
+
+	eventsCh := make(chan tmctypes.ResultEvent)
+	go chainHeight.subscribe(ctx, rpcClient, eventsCh)
+
+	eventsCh <- newBlockEvent(11)
+	height, err := chainHeight.WaitForNewBlock(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+
+	// the node is subscribed to again once its subscription is closed, and
+	// its latest height is used meanwhile
+	close(eventsCh)
+	newEventsCh := <-rpcClient.subs
+	require.Equal(t, 0, rpcClient.failures)
+
+	require.Eventually(t, func() bool {
+		height, err := chainHeight.GetChainHeight()
+		return err == nil && height == 12
+	}, time.Second, time.Millisecond)
+
+	newEventsCh <- newBlockEvent(13)
+	height, err = chainHeight.WaitForNewBlock(ctx, 12)
+	require.NoError(t, err)
+	require.Equal(t, int64(13), height)
+}
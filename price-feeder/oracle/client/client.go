@@ -2,23 +2,23 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	rpcClient "github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/rs/zerolog"
-	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
-	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
 
 	umeeapp "github.com/umee-network/umee/app"
 	umeeparams "github.com/umee-network/umee/app/params"
@@ -30,10 +30,8 @@ type (
 	OracleClient struct {
 		Logger              zerolog.Logger
 		ChainID             string
-		KeyringBackend      string
-		KeyringDir          string
-		KeyringPass         string
-		TMRPC               string
+		Signer              Signer
+		Nodes               *NodePool
 		RPCTimeout          time.Duration
 		OracleAddr          sdk.AccAddress
 		OracleAddrString    string
@@ -42,8 +40,11 @@ type (
 		Encoding            umeeparams.EncodingConfig
 		GasPrices           string
 		GasAdjustment       float64
-		GRPCEndpoint        string
-		KeyringPassphrase   string
+		Gas                 uint64
+		FeeGranter          sdk.AccAddress
+		Memo                string
+		ChainHeight         *ChainHeight
+		TxSubmitter         *TxSubmitter
 	}
 
 	passReader struct {
@@ -52,40 +53,121 @@ type (
 	}
 )
 
+// NewOracleClient returns a new OracleClient, signing transactions of the feeder
+// account with the given signer and tracking the chain height through the
+// NewBlock events of the Tendermint RPC nodes until ctx is canceled. The health
+// of the nodes is checked every healthCheckInterval, and the most up-to-date
+// healthy node is used for queries and transactions. A zero gas simulates the
+// gas of every transaction, and a non-empty fee granter pays the fees of the
+// transactions through an x/feegrant allowance.
 func NewOracleClient(
+	ctx context.Context,
 	logger zerolog.Logger,
 	chainID string,
-	keyringBackend string,
-	keyringDir string,
-	keyringPass string,
-	tmRPC string,
+	signer Signer,
+	nodeEndpoints []NodeEndpoints,
 	rpcTimeout time.Duration,
+	healthCheckInterval time.Duration,
 	oracleAddrString string,
 	validatorAddrString string,
-	grpcEndpoint string,
 	gasAdjustment float64,
+	gasPrices string,
+	gas uint64,
+	feeGranterString string,
+	memo string,
 ) (OracleClient, error) {
 	oracleAddr, err := sdk.AccAddressFromBech32(oracleAddrString)
 	if err != nil {
 		return OracleClient{}, err
 	}
 
-	return OracleClient{
-		Logger:              logger.With().Str("module", "oracle_client").Logger(),
-		ChainID:             chainID,
-		KeyringBackend:      keyringBackend,
-		KeyringDir:          keyringDir,
-		KeyringPass:         keyringPass,
-		TMRPC:               tmRPC,
-		RPCTimeout:          rpcTimeout,
-		OracleAddr:          oracleAddr,
-		OracleAddrString:    oracleAddrString,
-		ValidatorAddr:       sdk.ValAddress(validatorAddrString),
-		ValidatorAddrString: validatorAddrString,
-		Encoding:            umeeapp.MakeEncodingConfig(),
-		GasAdjustment:       gasAdjustment,
-		GRPCEndpoint:        grpcEndpoint,
-	}, nil
+	var feeGranter sdk.AccAddress
+	if len(feeGranterString) != 0 {
+		feeGranter, err = sdk.AccAddressFromBech32(feeGranterString)
+		if err != nil {
+			return OracleClient{}, fmt.Errorf("invalid fee granter: %w", err)
+		}
+	}
+	if !signer.Address().Equals(oracleAddr) {
+		return OracleClient{}, fmt.Errorf("signer address %s does not match feeder address %s", signer.Address(), oracleAddr)
+	}
+
+	oracleClient, err := NewReadOnlyOracleClient(ctx, logger, nodeEndpoints, rpcTimeout, healthCheckInterval)
+	if err != nil {
+		return OracleClient{}, err
+	}
+
+	oracleClient.ChainID = chainID
+	oracleClient.Signer = signer
+	oracleClient.OracleAddr = oracleAddr
+	oracleClient.OracleAddrString = oracleAddrString
+	oracleClient.ValidatorAddr = sdk.ValAddress(validatorAddrString)
+	oracleClient.ValidatorAddrString = validatorAddrString
+	oracleClient.GasPrices = gasPrices
+	oracleClient.GasAdjustment = gasAdjustment
+	oracleClient.Gas = gas
+	oracleClient.FeeGranter = feeGranter
+	oracleClient.Memo = memo
+
+	clientCtx, err := oracleClient.CreateClientContext()
+	if err != nil {
+		return OracleClient{}, err
+	}
+
+	txFactory, err := oracleClient.CreateTxFactory()
+	if err != nil {
+		return OracleClient{}, err
+	}
+
+	oracleClient.TxSubmitter = NewTxSubmitter(
+		logger,
+		clientCtx,
+		txFactory,
+		signer,
+		oracleClient.ChainHeight,
+		oracleClient.Nodes,
+	)
+
+	return oracleClient, nil
+}
+
+// NewReadOnlyOracleClient returns a new OracleClient that only queries the
+// chain, e.g. for the price-feeder to run in dry-run mode without any feeder
+// account. It tracks the chain height and the health of the nodes like
+// NewOracleClient, but cannot broadcast transactions.
+func NewReadOnlyOracleClient(
+	ctx context.Context,
+	logger zerolog.Logger,
+	nodeEndpoints []NodeEndpoints,
+	rpcTimeout time.Duration,
+	healthCheckInterval time.Duration,
+) (OracleClient, error) {
+	nodes, err := NewNodePool(ctx, logger, nodeEndpoints, rpcTimeout)
+	if err != nil {
+		return OracleClient{}, err
+	}
+
+	rpcClients := make([]tmrpcclient.Client, len(nodes.Nodes()))
+	for i, node := range nodes.Nodes() {
+		rpcClients[i] = node.RPCClient
+	}
+
+	oracleClient := OracleClient{
+		Logger:     logger.With().Str("module", "oracle_client").Logger(),
+		Nodes:      nodes,
+		RPCTimeout: rpcTimeout,
+		Encoding:   umeeapp.MakeEncodingConfig(),
+	}
+
+	chainHeight, err := NewChainHeight(ctx, rpcClients, oracleClient.GetChainHeight, logger)
+	if err != nil {
+		return OracleClient{}, fmt.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+	oracleClient.ChainHeight = chainHeight
+
+	go nodes.Start(ctx, healthCheckInterval)
+
+	return oracleClient, nil
 }
 
 func newPassReader(pass string) io.Reader {
@@ -106,41 +188,42 @@ func (r *passReader) Read(p []byte) (n int, err error) {
 	return n, err
 }
 
-// BroadcastTx attempts to broadcast a signed transaction. If it fails, a few re-attempts
-// will be made until the transaction succeeds or ultimately times out or fails.
+// BroadcastTx attempts to broadcast a signed transaction and waits for it to be
+// included in a block. If it fails, a few re-attempts will be made once per new
+// block until the transaction succeeds or ultimately times out or fails. The
+// response of the included transaction is returned, or the response of the last
+// failed attempt along with the error.
 // Ref: https://github.com/terra-money/oracle-feeder/blob/baef2a4a02f57a2ffeaa207932b2e03d7fb0fb25/feeder/src/vote.ts#L230
-func (oc OracleClient) BroadcastTx(nextBlockHeight int64, timeoutHeight int64, msgs ...sdk.Msg) error {
+func (oc OracleClient) BroadcastTx(
+	ctx context.Context,
+	nextBlockHeight int64,
+	timeoutHeight int64,
+	msgs ...sdk.Msg,
+) (*sdk.TxResponse, error) {
 	maxBlockHeight := nextBlockHeight + timeoutHeight
 	lastCheckHeight := nextBlockHeight - 1
 
-	clientCtx, err := oc.CreateClientContext()
-	if err != nil {
-		return err
-	}
-
-	factory, err := oc.CreateTxFactory()
-	if err != nil {
-		return err
-	}
+	var lastResp *sdk.TxResponse
 
 	// re-try voting until timeout
 	for lastCheckHeight < maxBlockHeight {
-		latestBlockHeight, err := rpcClient.GetChainHeight(clientCtx)
+		latestBlockHeight, err := oc.ChainHeight.WaitForNewBlock(ctx, lastCheckHeight)
 		if err != nil {
-			return err
+			return lastResp, err
 		}
-
-		if latestBlockHeight <= lastCheckHeight {
-			continue
+		if latestBlockHeight > maxBlockHeight {
+			break
 		}
 
 		// set last check height to latest block height
 		lastCheckHeight = latestBlockHeight
 
-		resp, err := BroadcastTx(clientCtx, factory, msgs...)
-		if resp != nil && resp.Code != 0 {
-			telemetry.IncrCounter(1, "failure", "tx", "code")
-			err = fmt.Errorf("invalid response code from tx: %d", resp.Code)
+		resp, err := oc.TxSubmitter.Submit(ctx, msgs...)
+		if err == nil {
+			resp, err = oc.TxSubmitter.WaitForTx(ctx, resp.TxHash, maxBlockHeight)
+			if err == nil && resp.Code != 0 {
+				err = fmt.Errorf("tx failed DeliverTx with code %d (%s): %s", resp.Code, resp.Codespace, resp.RawLog)
+			}
 		}
 		if err != nil {
 			var (
@@ -150,6 +233,17 @@ func (oc OracleClient) BroadcastTx(nextBlockHeight int64, timeoutHeight int64, m
 			if resp != nil {
 				code = resp.Code
 				hash = resp.TxHash
+				lastResp = resp
+			}
+			if code != 0 {
+				telemetry.IncrCounterWithLabels(
+					[]string{"failure", "tx", "code"},
+					1,
+					[]metrics.Label{
+						telemetry.NewLabel("codespace", resp.Codespace),
+						telemetry.NewLabel("code", strconv.FormatUint(uint64(code), 10)),
+					},
+				)
 			}
 
 			oc.Logger.Debug().
@@ -168,44 +262,28 @@ func (oc OracleClient) BroadcastTx(nextBlockHeight int64, timeoutHeight int64, m
 			Int64("tx_height", resp.Height).
 			Msg("successfully broadcasted tx")
 
-		return nil
+		return resp, nil
 	}
 
 	telemetry.IncrCounter(1, "failure", "tx", "timeout")
-	return errors.New("broadcasting tx timed out")
+	return lastResp, errors.New("broadcasting tx timed out")
 }
 
-// CreateClientContext creates an SDK client Context instance used for transaction
-// generation, signing and broadcasting.
-func (oc OracleClient) CreateClientContext() (client.Context, error) {
-	var keyringInput io.Reader
-	if len(oc.KeyringPass) > 0 {
-		keyringInput = newPassReader(oc.KeyringPass)
-	} else {
-		keyringInput = os.Stdin
-	}
-
-	kr, err := keyring.New("oracle", oc.KeyringBackend, oc.KeyringDir, keyringInput)
+// GetChainHeight queries the latest block height of the node currently in use.
+func (oc OracleClient) GetChainHeight(ctx context.Context) (int64, error) {
+	status, err := oc.Nodes.Active().RPCClient.Status(ctx)
 	if err != nil {
-		return client.Context{}, err
+		return 0, err
 	}
 
-	httpClient, err := tmjsonclient.DefaultHTTPClient(oc.TMRPC)
-	if err != nil {
-		return client.Context{}, err
-	}
-
-	httpClient.Timeout = oc.RPCTimeout
-
-	tmRPC, err := rpchttp.NewWithClient(oc.TMRPC, "/websocket", httpClient)
-	if err != nil {
-		return client.Context{}, err
-	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
 
-	keyInfo, err := kr.KeyByAddress(oc.OracleAddr)
-	if err != nil {
-		return client.Context{}, err
-	}
+// CreateClientContext creates an SDK client Context instance used for transaction
+// generation and broadcasting, through the node currently in use. Transactions
+// are signed by the Signer instead of a keyring.
+func (oc OracleClient) CreateClientContext() (client.Context, error) {
+	node := oc.Nodes.Active()
 
 	clientCtx := client.Context{
 		ChainID:           oc.ChainID,
@@ -218,12 +296,11 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 		Codec:             oc.Encoding.Marshaler,
 		LegacyAmino:       oc.Encoding.Amino,
 		Input:             os.Stdin,
-		NodeURI:           oc.TMRPC,
-		Client:            tmRPC,
-		Keyring:           kr,
+		NodeURI:           node.TMRPC,
+		Client:            node.RPCClient,
 		FromAddress:       oc.OracleAddr,
-		FromName:          keyInfo.GetName(),
-		From:              keyInfo.GetName(),
+		From:              oc.OracleAddrString,
+		FeeGranter:        oc.FeeGranter,
 		OutputFormat:      "json",
 		UseLedger:         false,
 		Simulate:          false,
@@ -236,7 +313,8 @@ func (oc OracleClient) CreateClientContext() (client.Context, error) {
 }
 
 // CreateTxFactory creates an SDK Factory instance used for transaction
-// generation, signing and broadcasting.
+// generation, signing and broadcasting. The gas of transactions is simulated
+// unless a fixed gas is set.
 func (oc OracleClient) CreateTxFactory() (tx.Factory, error) {
 	clientCtx, err := oc.CreateClientContext()
 	if err != nil {
@@ -249,9 +327,10 @@ func (oc OracleClient) CreateTxFactory() (tx.Factory, error) {
 		WithTxConfig(clientCtx.TxConfig).
 		WithGasAdjustment(oc.GasAdjustment).
 		WithGasPrices(oc.GasPrices).
-		WithKeybase(clientCtx.Keyring).
+		WithGas(oc.Gas).
+		WithMemo(oc.Memo).
 		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
-		WithSimulateAndExecute(true)
+		WithSimulateAndExecute(oc.Gas == 0)
 
 	return txFactory, nil
 }
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	umeeapp "github.com/umee-network/umee/app"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+// broadcastClient is a Tendermint RPC client at a fixed height, including
+// every tx broadcast to it in the block at that height.
+type broadcastClient struct {
+	tmrpcclient.Client
+
+	height int64
+}
+
+func (c *broadcastClient) Status(context.Context) (*tmctypes.ResultStatus, error) {
+	return &tmctypes.ResultStatus{SyncInfo: tmctypes.SyncInfo{LatestBlockHeight: c.height}}, nil
+}
+
+func (c *broadcastClient) BroadcastTxSync(_ context.Context, tx tmtypes.Tx) (*tmctypes.ResultBroadcastTx, error) {
+	return &tmctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (c *broadcastClient) Tx(_ context.Context, hash []byte, _ bool) (*tmctypes.ResultTx, error) {
+	return &tmctypes.ResultTx{Hash: hash, Height: c.height}, nil
+}
+
+func TestBroadcastTx_SubscriptionsClosed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	signer := newTestKeyringSigner(t)
+	encoding := umeeapp.MakeEncodingConfig()
+	tmClient := &broadcastClient{height: 11}
+
+	chainHeight := &ChainHeight{
+		Logger: zerolog.Nop(),
+		pollHeight: func(ctx context.Context) (int64, error) {
+			status, err := tmClient.Status(ctx)
+			if err != nil {
+				return 0, err
+			}
+			return status.SyncInfo.LatestBlockHeight, nil
+		},
+		pollInterval:  time.Millisecond,
+		lastHeight:    10,
+		newBlock:      make(chan struct{}),
+		subscriptions: 1,
+	}
+
+	clientCtx := client.Context{
+		ChainID:       testChainID,
+		Client:        tmClient,
+		TxConfig:      encoding.TxConfig,
+		BroadcastMode: flags.BroadcastSync,
+	}
+	txf := tx.Factory{}.
+		WithChainID(testChainID).
+		WithTxConfig(encoding.TxConfig).
+		WithGas(100000)
+
+	submitter := NewTxSubmitter(zerolog.Nop(), clientCtx, txf, signer, chainHeight, nil)
+	submitter.synced = true
+
+	oracleClient := OracleClient{
+		Logger:      zerolog.Nop(),
+		ChainHeight: chainHeight,
+		TxSubmitter: submitter,
+	}
+
+	// the chain height is polled once the subscriptions of all nodes are
+	// closed, so that the prevote is still broadcast
+	chainHeight.closeSubscription(ctx)
+
+	resp, err := oracleClient.BroadcastTx(ctx, 11, 4, &oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      "hash",
+		Feeder:    signer.Address().String(),
+		Validator: "validator",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(11), resp.Height)
+
+	height, err := chainHeight.GetChainHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+}
@@ -0,0 +1,277 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/rs/zerolog"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+
+	"github.com/umee-network/umee/price-feeder/telemetry"
+)
+
+var (
+	errNoNodes        = errors.New("no rpc nodes configured")
+	errNoHealthyNode  = errors.New("no healthy rpc node")
+	errNodeFailedOver = errors.New("node failed over")
+)
+
+type (
+	// NodeEndpoints defines the Tendermint RPC and Cosmos gRPC endpoints of an
+	// Umee node.
+	NodeEndpoints struct {
+		TMRPC string
+		GRPC  string
+	}
+
+	// Node defines an Umee node through which the chain is queried and
+	// transactions are broadcast.
+	Node struct {
+		NodeEndpoints
+
+		// RPCClient is the Tendermint RPC client of the node, whose requests
+		// time out after the configured RPC timeout.
+		RPCClient *rpchttp.HTTP
+	}
+
+	// NodeStatus defines the result of the latest health check of a node.
+	NodeStatus struct {
+		Height     int64
+		CatchingUp bool
+		Err        error
+	}
+
+	// NodePool defines a set of Umee nodes of which the most up-to-date healthy
+	// one is used. The health of the nodes is checked periodically, and callers
+	// fail over to another node as soon as the active one fails a request.
+	NodePool struct {
+		logger zerolog.Logger
+		nodes  []*Node
+
+		mtx      sync.RWMutex
+		active   int
+		statuses []NodeStatus
+	}
+)
+
+// Healthy returns true if the node responded to its health check and is not
+// catching up with the chain.
+func (s NodeStatus) Healthy() bool {
+	return s.Err == nil && !s.CatchingUp
+}
+
+// NewNodePool returns a new NodePool of the nodes of the given endpoints, in
+// order of preference, and checks their health once to select the active node.
+func NewNodePool(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints []NodeEndpoints,
+	rpcTimeout time.Duration,
+) (*NodePool, error) {
+	if len(endpoints) == 0 {
+		return nil, errNoNodes
+	}
+
+	nodes := make([]*Node, len(endpoints))
+	for i, e := range endpoints {
+		httpClient, err := tmjsonclient.DefaultHTTPClient(e.TMRPC)
+		if err != nil {
+			return nil, err
+		}
+
+		httpClient.Timeout = rpcTimeout
+
+		rpcClient, err := rpchttp.NewWithClient(e.TMRPC, "/websocket", httpClient)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes[i] = &Node{
+			NodeEndpoints: e,
+			RPCClient:     rpcClient,
+		}
+	}
+
+	p := &NodePool{
+		logger:   logger.With().Str("module", "node_pool").Logger(),
+		nodes:    nodes,
+		statuses: make([]NodeStatus, len(nodes)),
+	}
+
+	if err := p.CheckHealth(ctx); err != nil {
+		p.logger.Warn().Err(err).Msg("using the first rpc node until a node is healthy")
+	}
+	p.setActive(p.active)
+
+	return p, nil
+}
+
+// Nodes returns all the nodes of the pool.
+func (p *NodePool) Nodes() []*Node {
+	return p.nodes
+}
+
+// Active returns the node currently in use.
+func (p *NodePool) Active() *Node {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return p.nodes[p.active]
+}
+
+// Start checks the health of the nodes at the given interval until ctx is
+// canceled.
+func (p *NodePool) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if err := p.CheckHealth(ctx); err != nil && ctx.Err() == nil {
+				p.logger.Err(err).Str("tmrpc", p.Active().TMRPC).Msg("keeping unhealthy rpc node")
+			}
+		}
+	}
+}
+
+// CheckHealth queries the status of every node, and switches to the most
+// up-to-date healthy node. An error is returned if no node is healthy, in which
+// case the active node is kept.
+func (p *NodePool) CheckHealth(ctx context.Context) error {
+	statuses := make([]NodeStatus, len(p.nodes))
+
+	var wg sync.WaitGroup
+	for i, node := range p.nodes {
+		wg.Add(1)
+
+		go func(i int, node *Node) {
+			defer wg.Done()
+
+			status, err := node.RPCClient.Status(ctx)
+			if err != nil {
+				statuses[i].Err = err
+				return
+			}
+
+			statuses[i].Height = status.SyncInfo.LatestBlockHeight
+			statuses[i].CatchingUp = status.SyncInfo.CatchingUp
+		}(i, node)
+	}
+	wg.Wait()
+
+	for i, status := range statuses {
+		if !status.Healthy() {
+			p.logger.Debug().
+				Err(status.Err).
+				Str("tmrpc", p.nodes[i].TMRPC).
+				Bool("catching_up", status.CatchingUp).
+				Msg("unhealthy rpc node")
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.statuses = statuses
+	return p.selectNode()
+}
+
+// Failover marks the given node as unhealthy until the next health check, and
+// switches to the most up-to-date healthy node among the others. It returns the
+// node in use afterwards, which is the given node if no other node is healthy.
+func (p *NodePool) Failover(node *Node) *Node {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for i, n := range p.nodes {
+		if n == node {
+			p.statuses[i].Err = errNodeFailedOver
+		}
+	}
+
+	if err := p.selectNode(); err != nil {
+		p.logger.Warn().Err(err).Str("tmrpc", node.TMRPC).Msg("unable to fail over")
+	}
+
+	return p.nodes[p.active]
+}
+
+// selectNode switches to the most up-to-date healthy node according to the
+// latest statuses. The caller must hold the lock.
+func (p *NodePool) selectNode() error {
+	best := bestNode(p.statuses, p.active)
+	if best < 0 {
+		return errNoHealthyNode
+	}
+
+	if best != p.active {
+		p.logger.Info().
+			Str("from", p.nodes[p.active].TMRPC).
+			Str("to", p.nodes[best].TMRPC).
+			Int64("height", p.statuses[best].Height).
+			Msg("switching rpc node")
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"rpc", "failover"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("from", p.nodes[p.active].TMRPC),
+				telemetry.NewLabel("to", p.nodes[best].TMRPC),
+			},
+		)
+
+		p.setActive(best)
+	}
+
+	return nil
+}
+
+// setActive sets the node in use, and reports it in the rpc.active gauge of
+// every node.
+func (p *NodePool) setActive(active int) {
+	p.active = active
+
+	for i, node := range p.nodes {
+		var val float32
+		if i == active {
+			val = 1
+		}
+
+		telemetry.SetGaugeWithLabels(
+			[]string{"rpc", "active"},
+			val,
+			[]metrics.Label{
+				telemetry.NewLabel("tmrpc", node.TMRPC),
+				telemetry.NewLabel("grpc", node.GRPC),
+			},
+		)
+	}
+}
+
+// bestNode returns the index of the healthy node with the highest height, or
+// -1 if no node is healthy. The active node is preferred over other nodes of
+// the same height, so that nodes are not switched needlessly.
+func bestNode(statuses []NodeStatus, active int) int {
+	best := -1
+	for i, status := range statuses {
+		if !status.Healthy() {
+			continue
+		}
+
+		if best < 0 ||
+			status.Height > statuses[best].Height ||
+			(status.Height == statuses[best].Height && i == active) {
+			best = i
+		}
+	}
+
+	return best
+}
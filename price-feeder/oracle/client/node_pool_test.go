@@ -0,0 +1,74 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBestNode(t *testing.T) {
+	errUnreachable := errors.New("unreachable")
+
+	testCases := []struct {
+		name     string
+		statuses []NodeStatus
+		active   int
+		expected int
+	}{
+		{
+			"highest healthy node",
+			[]NodeStatus{{Height: 10}, {Height: 12}, {Height: 11}},
+			0,
+			1,
+		},
+		{
+			"active node on ties",
+			[]NodeStatus{{Height: 12}, {Height: 12}, {Height: 11}},
+			1,
+			1,
+		},
+		{
+			"unhealthy nodes are skipped",
+			[]NodeStatus{{Height: 10}, {Height: 15, CatchingUp: true}, {Err: errUnreachable}},
+			2,
+			0,
+		},
+		{
+			"no healthy node",
+			[]NodeStatus{{Height: 15, CatchingUp: true}, {Err: errUnreachable}},
+			0,
+			-1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, bestNode(tc.statuses, tc.active))
+		})
+	}
+}
+
+func TestNodePool_Failover(t *testing.T) {
+	nodes := []*Node{
+		{NodeEndpoints: NodeEndpoints{TMRPC: "http://node0:26657", GRPC: "node0:9090"}},
+		{NodeEndpoints: NodeEndpoints{TMRPC: "http://node1:26657", GRPC: "node1:9090"}},
+		{NodeEndpoints: NodeEndpoints{TMRPC: "http://node2:26657", GRPC: "node2:9090"}},
+	}
+	pool := &NodePool{
+		logger:   zerolog.Nop(),
+		nodes:    nodes,
+		statuses: []NodeStatus{{Height: 12}, {Height: 11}, {Height: 12, CatchingUp: true}},
+	}
+
+	// fail over to the most up-to-date healthy node among the others
+	require.Equal(t, nodes[1], pool.Failover(nodes[0]))
+	require.Equal(t, nodes[1], pool.Active())
+
+	// keep the failed node if no other node is healthy
+	require.Equal(t, nodes[1], pool.Failover(nodes[1]))
+	require.Equal(t, nodes[1], pool.Active())
+}
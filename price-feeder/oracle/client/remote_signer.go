@@ -0,0 +1,353 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/umee-network/umee/ante"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+// The remote signing protocol consists of two JSON over HTTP endpoints served by
+// a signing daemon, which holds the key of the feeder account:
+//
+//	GET  <url>/v1/pubkey?address=<address>
+//	  => {"pub_key": "<base64 compressed secp256k1 key>"}
+//
+//	POST <url>/v1/sign {"address": "<address>", "sign_doc": "<base64 SignDoc>"}
+//	  => {"signature": "<base64 signature>"}
+//
+// Failures are reported with a non-200 status and {"error": "<reason>"}. The
+// daemon is expected to only sign oracle messages of the feeder account, which
+// RemoteSignerHandler implements.
+const (
+	remoteSignerPubKeyPath = "/v1/pubkey"
+	remoteSignerSignPath   = "/v1/sign"
+)
+
+type (
+	// RemoteSigner defines a Signer delegating signatures to a signing daemon
+	// over HTTP, so that the key of the feeder account is not stored on the
+	// price-feeder host.
+	RemoteSigner struct {
+		url     string
+		client  *http.Client
+		address sdk.AccAddress
+		pubKey  cryptotypes.PubKey
+	}
+
+	// RemoteSignerPubKeyResponse defines the response of the pubkey endpoint
+	// of the remote signing protocol.
+	RemoteSignerPubKeyResponse struct {
+		PubKey []byte `json:"pub_key"`
+	}
+
+	// RemoteSignerSignRequest defines the request of the sign endpoint of the
+	// remote signing protocol.
+	RemoteSignerSignRequest struct {
+		Address string `json:"address"`
+		SignDoc []byte `json:"sign_doc"`
+	}
+
+	// RemoteSignerSignResponse defines the response of the sign endpoint of the
+	// remote signing protocol.
+	RemoteSignerSignResponse struct {
+		Signature []byte `json:"signature"`
+	}
+
+	// RemoteSignerErrorResponse defines the response of the remote signing
+	// protocol endpoints on failure.
+	RemoteSignerErrorResponse struct {
+		Error string `json:"error"`
+	}
+
+	// remoteSignerHandler implements the daemon side of the remote signing
+	// protocol.
+	remoteSignerHandler struct {
+		signer     Signer
+		chainID    string
+		maxFees    sdk.Coins
+		feeGranter string
+		cdc        codec.Codec
+		ir         codectypes.InterfaceRegistry
+	}
+)
+
+// NewRemoteSigner returns a RemoteSigner for the feeder account of the given
+// address, signing through the daemon at the given URL. The public key of the
+// account is queried once from the daemon.
+func NewRemoteSigner(
+	ctx context.Context,
+	signerURL string,
+	timeout time.Duration,
+	address sdk.AccAddress,
+) (*RemoteSigner, error) {
+	s := &RemoteSigner{
+		url:     strings.TrimSuffix(signerURL, "/"),
+		client:  &http.Client{Timeout: timeout},
+		address: address,
+	}
+
+	query := url.Values{}
+	query.Set("address", address.String())
+
+	var resp RemoteSignerPubKeyResponse
+	if err := s.do(ctx, http.MethodGet, remoteSignerPubKeyPath+"?"+query.Encode(), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.PubKey) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid remote signer public key length: %d", len(resp.PubKey))
+	}
+
+	pubKey := &secp256k1.PubKey{Key: resp.PubKey}
+	if !sdk.AccAddress(pubKey.Address()).Equals(address) {
+		return nil, fmt.Errorf("remote signer public key does not match address %s", address)
+	}
+
+	s.pubKey = pubKey
+	return s, nil
+}
+
+// Address implements the Signer interface.
+func (s *RemoteSigner) Address() sdk.AccAddress {
+	return s.address
+}
+
+// PubKey implements the Signer interface.
+func (s *RemoteSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Sign implements the Signer interface.
+func (s *RemoteSigner) Sign(ctx context.Context, signBytes []byte) ([]byte, error) {
+	req := RemoteSignerSignRequest{
+		Address: s.address.String(),
+		SignDoc: signBytes,
+	}
+
+	var resp RemoteSignerSignResponse
+	if err := s.do(ctx, http.MethodPost, remoteSignerSignPath, req, &resp); err != nil {
+		return nil, err
+	}
+
+	if !s.pubKey.VerifySignature(signBytes, resp.Signature) {
+		return nil, fmt.Errorf("invalid remote signer signature")
+	}
+
+	return resp.Signature, nil
+}
+
+// do sends a request to the remote signer and unmarshals its response into
+// resp.
+func (s *RemoteSigner) do(ctx context.Context, method, path string, req, resp interface{}) error {
+	var body io.Reader = http.NoBody
+	if req != nil {
+		bz, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(bz)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, s.url+path, body)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := s.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to make remote signer request: %w", err)
+	}
+
+	defer httpResp.Body.Close()
+
+	bz, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read remote signer response body: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp RemoteSignerErrorResponse
+		if err := json.Unmarshal(bz, &errResp); err == nil && len(errResp.Error) != 0 {
+			return fmt.Errorf("remote signer request failed with status %d: %s", httpResp.StatusCode, errResp.Error)
+		}
+		return fmt.Errorf("remote signer request failed with status %d", httpResp.StatusCode)
+	}
+
+	if err := json.Unmarshal(bz, resp); err != nil {
+		return fmt.Errorf("failed to unmarshal remote signer response body: %w", err)
+	}
+
+	return nil
+}
+
+// NewRemoteSignerHandler returns an http.Handler serving the remote signing
+// protocol with the given signer, e.g. a KeyringSigner on a separate signing
+// host, or a test stub. Only transactions of the given chain consisting of
+// x/oracle pre-votes and votes fed by the signer's account are signed. Only the
+// x/oracle messages are registered to decode transactions, so that any other
+// message is rejected. So that a compromised feeder host cannot drain the
+// account through fees, transactions must not pay more than maxFees, nor use
+// more gas than oracle transactions exempt from fees, and their fees may only
+// be granted by feeGranter.
+func NewRemoteSignerHandler(signer Signer, chainID string, maxFees sdk.Coins, feeGranter string) http.Handler {
+	ir := codectypes.NewInterfaceRegistry()
+	oracletypes.RegisterInterfaces(ir)
+
+	h := &remoteSignerHandler{
+		signer:     signer,
+		chainID:    chainID,
+		maxFees:    maxFees,
+		feeGranter: feeGranter,
+		cdc:        codec.NewProtoCodec(ir),
+		ir:         ir,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(remoteSignerPubKeyPath, h.handlePubKey)
+	mux.HandleFunc(remoteSignerSignPath, h.handleSign)
+
+	return mux
+}
+
+func (h *remoteSignerHandler) handlePubKey(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeRemoteSignerError(w, http.StatusMethodNotAllowed, fmt.Errorf("unsupported method %s", r.Method))
+		return
+	}
+
+	if address := r.URL.Query().Get("address"); address != h.signer.Address().String() {
+		writeRemoteSignerError(w, http.StatusForbidden, fmt.Errorf("unknown address %s", address))
+		return
+	}
+
+	writeRemoteSignerResponse(w, RemoteSignerPubKeyResponse{PubKey: h.signer.PubKey().Bytes()})
+}
+
+func (h *remoteSignerHandler) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeRemoteSignerError(w, http.StatusMethodNotAllowed, fmt.Errorf("unsupported method %s", r.Method))
+		return
+	}
+
+	var req RemoteSignerSignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeRemoteSignerError(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+		return
+	}
+
+	if req.Address != h.signer.Address().String() {
+		writeRemoteSignerError(w, http.StatusForbidden, fmt.Errorf("unknown address %s", req.Address))
+		return
+	}
+
+	if err := h.validateSignDoc(req.SignDoc); err != nil {
+		writeRemoteSignerError(w, http.StatusForbidden, err)
+		return
+	}
+
+	sig, err := h.signer.Sign(r.Context(), req.SignDoc)
+	if err != nil {
+		writeRemoteSignerError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeRemoteSignerResponse(w, RemoteSignerSignResponse{Signature: sig})
+}
+
+// validateSignDoc returns an error unless the given sign doc belongs to the
+// expected chain, only contains x/oracle pre-votes and votes fed by the
+// signer's account, and its fees and gas are within the limits of the handler.
+func (h *remoteSignerHandler) validateSignDoc(signBytes []byte) error {
+	var signDoc txtypes.SignDoc
+	if err := h.cdc.Unmarshal(signBytes, &signDoc); err != nil {
+		return fmt.Errorf("failed to decode sign doc: %w", err)
+	}
+
+	if signDoc.ChainId != h.chainID {
+		return fmt.Errorf("unexpected chain id %s", signDoc.ChainId)
+	}
+
+	var body txtypes.TxBody
+	if err := h.cdc.Unmarshal(signDoc.BodyBytes, &body); err != nil {
+		return fmt.Errorf("failed to decode tx body: %w", err)
+	}
+	if len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 {
+		return fmt.Errorf("unsupported tx extension options")
+	}
+	if err := body.UnpackInterfaces(h.ir); err != nil {
+		return fmt.Errorf("failed to decode tx messages: %w", err)
+	}
+
+	msgs := (&txtypes.Tx{Body: &body}).GetMsgs()
+	if len(msgs) == 0 {
+		return fmt.Errorf("tx has no messages")
+	}
+
+	feeder := h.signer.Address().String()
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *oracletypes.MsgAggregateExchangeRatePrevote:
+			if msg.Feeder != feeder {
+				return fmt.Errorf("unexpected pre-vote feeder %s", msg.Feeder)
+			}
+
+		case *oracletypes.MsgAggregateExchangeRateVote:
+			if msg.Feeder != feeder {
+				return fmt.Errorf("unexpected vote feeder %s", msg.Feeder)
+			}
+
+		default:
+			return fmt.Errorf("unsupported message type %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := h.cdc.Unmarshal(signDoc.AuthInfoBytes, &authInfo); err != nil {
+		return fmt.Errorf("failed to decode tx auth info: %w", err)
+	}
+
+	fee := authInfo.Fee
+	if fee == nil {
+		fee = &txtypes.Fee{}
+	}
+	if maxGas := uint64(len(msgs)) * ante.MaxOracleMsgGasUsage; fee.GasLimit > maxGas {
+		return fmt.Errorf("gas limit %d exceeds the maximum gas of oracle txs: %d", fee.GasLimit, maxGas)
+	}
+	if !fee.Amount.IsZero() && !fee.Amount.IsAllLTE(h.maxFees) {
+		return fmt.Errorf("fees %s exceed the maximum fees: %s", fee.Amount, h.maxFees)
+	}
+	if len(fee.Granter) != 0 && fee.Granter != h.feeGranter {
+		return fmt.Errorf("unexpected fee granter %s", fee.Granter)
+	}
+
+	return nil
+}
+
+func writeRemoteSignerResponse(w http.ResponseWriter, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func writeRemoteSignerError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(RemoteSignerErrorResponse{Error: err.Error()})
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/ante"
+	umeeapp "github.com/umee-network/umee/app"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+const (
+	testChainID    = "umee-test"
+	testFeeGranter = "umee1y6xz2ggfc0pcsmyjlekh0j9pxh6hk87ymc9due"
+)
+
+var testMaxFees = sdk.NewCoins(sdk.NewInt64Coin("uumee", 1000))
+
+func newTestKeyringSigner(t *testing.T) *KeyringSigner {
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("feeder", keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	return &KeyringSigner{
+		keyring: kr,
+		address: info.GetAddress(),
+		pubKey:  info.GetPubKey(),
+	}
+}
+
+func newTestSignDoc(t *testing.T, chainID string, msgs ...sdk.Msg) []byte {
+	fee := txtypes.Fee{GasLimit: uint64(len(msgs)) * ante.MaxOracleMsgGasUsage}
+	return newTestTxSignDoc(t, chainID, txtypes.TxBody{}, fee, msgs...)
+}
+
+// newTestTxSignDoc returns the sign doc of a tx with the given body, fee and
+// messages.
+func newTestTxSignDoc(t *testing.T, chainID string, body txtypes.TxBody, fee txtypes.Fee, msgs ...sdk.Msg) []byte {
+	for _, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		body.Messages = append(body.Messages, any)
+	}
+
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+
+	authInfoBytes, err := (&txtypes.AuthInfo{Fee: &fee}).Marshal()
+	require.NoError(t, err)
+
+	signBytes, err := (&txtypes.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       chainID,
+		AccountNumber: 1,
+	}).Marshal()
+	require.NoError(t, err)
+
+	return signBytes
+}
+
+func TestRemoteSigner(t *testing.T) {
+	keyringSigner := newTestKeyringSigner(t)
+	feeder := keyringSigner.Address().String()
+
+	server := httptest.NewServer(NewRemoteSignerHandler(keyringSigner, testChainID, testMaxFees, testFeeGranter))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	// the public key of unknown accounts is not served
+	_, err := NewRemoteSigner(ctx, server.URL, time.Second, sdk.AccAddress([]byte("unknown_address_____")))
+	require.Error(t, err)
+
+	signer, err := NewRemoteSigner(ctx, server.URL, time.Second, keyringSigner.Address())
+	require.NoError(t, err)
+	require.True(t, keyringSigner.PubKey().Equals(signer.PubKey()))
+
+	prevote := &oracletypes.MsgAggregateExchangeRatePrevote{Hash: "hash", Feeder: feeder, Validator: "validator"}
+	vote := &oracletypes.MsgAggregateExchangeRateVote{Salt: "salt", Feeder: feeder, Validator: "validator"}
+
+	signBytes := newTestSignDoc(t, testChainID, prevote, vote)
+	sig, err := signer.Sign(ctx, signBytes)
+	require.NoError(t, err)
+	require.True(t, keyringSigner.PubKey().VerifySignature(signBytes, sig))
+
+	// fees within the limits granted by the fee granter are signed
+	signBytes = newTestTxSignDoc(t, testChainID, txtypes.TxBody{}, txtypes.Fee{
+		Amount:   testMaxFees,
+		GasLimit: ante.MaxOracleMsgGasUsage,
+		Granter:  testFeeGranter,
+	}, prevote)
+	_, err = signer.Sign(ctx, signBytes)
+	require.NoError(t, err)
+
+	extensionOption, err := codectypes.NewAnyWithValue(prevote)
+	require.NoError(t, err)
+
+	testCases := map[string][]byte{
+		"unexpected chain": newTestSignDoc(t, "other-chain", prevote),
+		"unexpected feeder": newTestSignDoc(t, testChainID, &oracletypes.MsgAggregateExchangeRatePrevote{
+			Hash:      "hash",
+			Feeder:    sdk.AccAddress([]byte("other_feeder________")).String(),
+			Validator: "validator",
+		}),
+		"unsupported message": newTestSignDoc(t, testChainID, prevote, &banktypes.MsgSend{
+			FromAddress: feeder,
+			ToAddress:   feeder,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("uumee", 1)),
+		}),
+		"no messages": newTestSignDoc(t, testChainID),
+		"gas limit above oracle txs": newTestTxSignDoc(t, testChainID, txtypes.TxBody{}, txtypes.Fee{
+			GasLimit: 2*ante.MaxOracleMsgGasUsage + 1,
+		}, prevote, vote),
+		"fees above the maximum": newTestTxSignDoc(t, testChainID, txtypes.TxBody{}, txtypes.Fee{
+			Amount:   sdk.NewCoins(sdk.NewInt64Coin("uumee", 1001)),
+			GasLimit: ante.MaxOracleMsgGasUsage,
+		}, prevote),
+		"fees in another denom": newTestTxSignDoc(t, testChainID, txtypes.TxBody{}, txtypes.Fee{
+			Amount:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+			GasLimit: ante.MaxOracleMsgGasUsage,
+		}, prevote),
+		"unexpected fee granter": newTestTxSignDoc(t, testChainID, txtypes.TxBody{}, txtypes.Fee{
+			GasLimit: ante.MaxOracleMsgGasUsage,
+			Granter:  feeder,
+		}, prevote),
+		"extension options": newTestTxSignDoc(t, testChainID, txtypes.TxBody{
+			ExtensionOptions: []*codectypes.Any{extensionOption},
+		}, txtypes.Fee{GasLimit: ante.MaxOracleMsgGasUsage}, prevote),
+		"non-critical extension options": newTestTxSignDoc(t, testChainID, txtypes.TxBody{
+			NonCriticalExtensionOptions: []*codectypes.Any{extensionOption},
+		}, txtypes.Fee{GasLimit: ante.MaxOracleMsgGasUsage}, prevote),
+		"invalid doc": []byte("invalid"),
+	}
+
+	for name, signBytes := range testCases {
+		signBytes := signBytes
+
+		t.Run(name, func(t *testing.T) {
+			_, err := signer.Sign(ctx, signBytes)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSignTx(t *testing.T) {
+	signer := newTestKeyringSigner(t)
+
+	encoding := umeeapp.MakeEncodingConfig()
+	txf := tx.Factory{}.
+		WithChainID(testChainID).
+		WithTxConfig(encoding.TxConfig).
+		WithAccountNumber(1).
+		WithSequence(2)
+
+	txBuilder := encoding.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      "hash",
+		Feeder:    signer.Address().String(),
+		Validator: "validator",
+	}))
+
+	require.NoError(t, signTx(context.Background(), encoding.TxConfig, txf, txBuilder, signer))
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, uint64(2), sigs[0].Sequence)
+	require.True(t, signer.PubKey().Equals(sigs[0].PubKey))
+}
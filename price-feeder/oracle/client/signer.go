@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ Signer = (*KeyringSigner)(nil)
+	_ Signer = (*RemoteSigner)(nil)
+)
+
+type (
+	// Signer defines the interface used to sign the transactions of the feeder
+	// account, so that its key does not have to be stored on the price-feeder
+	// host.
+	Signer interface {
+		// Address returns the address of the feeder account.
+		Address() sdk.AccAddress
+
+		// PubKey returns the public key of the feeder account.
+		PubKey() cryptotypes.PubKey
+
+		// Sign returns the signature of the given SIGN_MODE_DIRECT sign bytes,
+		// i.e. a marshaled SignDoc.
+		Sign(ctx context.Context, signBytes []byte) ([]byte, error)
+	}
+
+	// KeyringSigner defines a Signer backed by a local SDK keyring.
+	KeyringSigner struct {
+		keyring keyring.Keyring
+		address sdk.AccAddress
+		pubKey  cryptotypes.PubKey
+	}
+)
+
+// NewKeyringSigner opens the keyring of the given backend in dir and returns a
+// KeyringSigner for the key of the given address. The keyring is opened once,
+// prompting for its passphrase on stdin if keyringPass is empty.
+func NewKeyringSigner(backend, dir, keyringPass string, address sdk.AccAddress) (*KeyringSigner, error) {
+	var keyringInput io.Reader
+	if len(keyringPass) > 0 {
+		keyringInput = newPassReader(keyringPass)
+	} else {
+		keyringInput = os.Stdin
+	}
+
+	kr, err := keyring.New("oracle", backend, dir, keyringInput)
+	if err != nil {
+		return nil, err
+	}
+
+	keyInfo, err := kr.KeyByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		address: address,
+		pubKey:  keyInfo.GetPubKey(),
+	}, nil
+}
+
+// Address implements the Signer interface.
+func (s *KeyringSigner) Address() sdk.AccAddress {
+	return s.address
+}
+
+// PubKey implements the Signer interface.
+func (s *KeyringSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Sign implements the Signer interface.
+func (s *KeyringSigner) Sign(_ context.Context, signBytes []byte) ([]byte, error) {
+	sig, _, err := s.keyring.SignByAddress(s.address, signBytes)
+	return sig, err
+}
+
+// signTx signs the transaction being built by txBuilder with the given signer,
+// in SIGN_MODE_DIRECT. It mirrors tx.Sign from the SDK, which only supports
+// signing with a keyring.
+func signTx(
+	ctx context.Context,
+	txConfig client.TxConfig,
+	txf tx.Factory,
+	txBuilder client.TxBuilder,
+	signer Signer,
+) error {
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+	}
+
+	// SignerInfos are needed to generate the sign bytes, so the signature is
+	// first set with an empty signature.
+	sigData := signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: nil,
+	}
+	sig := signing.SignatureV2{
+		PubKey:   signer.PubKey(),
+		Data:     &sigData,
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return err
+	}
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	sigBytes, err := signer.Sign(ctx, signBytes)
+	if err != nil {
+		return fmt.Errorf("failed to sign tx: %w", err)
+	}
+
+	sigData = signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: sigBytes,
+	}
+	sig = signing.SignatureV2{
+		PubKey:   signer.PubKey(),
+		Data:     &sigData,
+		Sequence: txf.Sequence(),
+	}
+
+	return txBuilder.SetSignatures(sig)
+}
@@ -1,77 +1,245 @@
 package client
 
 import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/umee-network/umee/ante"
 )
 
-// BroadcastTx attempts to generate, sign and broadcast a transaction with the
-// given set of messages. It will also simulate gas requirements if necessary.
-// It will return an error upon failure.
-//
-// Note, BroadcastTx is copied from the SDK except it removes a few unnecessary
-// things like prompting for confirmation and printing the response. Instead,
-// we return the TxResponse.
-func BroadcastTx(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
-	txf, err := prepareFactory(clientCtx, txf)
-	if err != nil {
-		return nil, err
+const (
+	// txPollInitialBackoff and txPollMaxBackoff bound the time waited between
+	// queries for the inclusion of a transaction.
+	txPollInitialBackoff = 250 * time.Millisecond
+	txPollMaxBackoff     = 2 * time.Second
+)
+
+// TxSubmitter defines a long-lived submitter of the transactions of the feeder
+// account. The client context and tx factory are created once, and the account
+// sequence is tracked locally, only being queried again after the node reports
+// a sequence mismatch, e.g. when another process used the account. If a node
+// pool is set, transactions go through its active node, failing over to another
+// node when a broadcast fails.
+type TxSubmitter struct {
+	logger      zerolog.Logger
+	clientCtx   client.Context
+	txf         tx.Factory
+	signer      Signer
+	chainHeight *ChainHeight
+	nodes       *NodePool
+
+	mtx           sync.Mutex
+	synced        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+// NewTxSubmitter returns a new TxSubmitter. The account number and sequence are
+// queried on the first submission.
+func NewTxSubmitter(
+	logger zerolog.Logger,
+	clientCtx client.Context,
+	txf tx.Factory,
+	signer Signer,
+	chainHeight *ChainHeight,
+	nodes *NodePool,
+) *TxSubmitter {
+	return &TxSubmitter{
+		logger:      logger.With().Str("module", "tx_submitter").Logger(),
+		clientCtx:   clientCtx,
+		txf:         txf,
+		signer:      signer,
+		chainHeight: chainHeight,
+		nodes:       nodes,
 	}
+}
 
-	_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
-	if err != nil {
-		return nil, err
+// clientContext returns the node currently in use, if any, along with the client
+// context querying and broadcasting through it.
+func (s *TxSubmitter) clientContext() (*Node, client.Context) {
+	if s.nodes == nil {
+		return nil, s.clientCtx
 	}
 
-	txf = txf.WithGas(adjusted)
+	node := s.nodes.Active()
+	return node, s.clientCtx.WithClient(node.RPCClient).WithNodeURI(node.TMRPC)
+}
 
-	unsignedTx, err := tx.BuildUnsignedTx(txf, msgs...)
-	if err != nil {
-		return nil, err
+// Submit signs and broadcasts a transaction with the given set of messages and
+// the next account sequence. It returns the CheckTx response of the node, and
+// an error if the transaction was rejected. The sequence is only consumed if
+// the transaction passed CheckTx.
+func (s *TxSubmitter) Submit(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	node, clientCtx := s.clientContext()
+
+	if !s.synced {
+		if err := s.sync(clientCtx); err != nil {
+			return nil, err
+		}
 	}
 
-	unsignedTx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
-	// unsignedTx.SetFeePayer(clientCtx.GetFeePayerAddress())
+	txf := s.txf.
+		WithAccountNumber(s.accountNumber).
+		WithSequence(s.sequence)
 
-	if err = tx.Sign(txf, clientCtx.GetFromName(), unsignedTx, true); err != nil {
+	txBytes, err := BuildTx(ctx, clientCtx, txf, s.signer, msgs...)
+	if err != nil {
+		// the gas simulation fails on a sequence mismatch as well
+		if strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error()) {
+			s.synced = false
+		}
 		return nil, err
 	}
 
-	txBytes, err := clientCtx.TxConfig.TxEncoder()(unsignedTx.GetTx())
+	resp, err := clientCtx.BroadcastTx(txBytes)
 	if err != nil {
+		if node != nil {
+			s.nodes.Failover(node)
+		}
 		return nil, err
 	}
 
-	return clientCtx.BroadcastTx(txBytes)
+	if resp.Code != 0 {
+		if resp.Codespace == sdkerrors.RootCodespace && resp.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			s.synced = false
+		}
+		return resp, fmt.Errorf("tx failed CheckTx with code %d (%s): %s", resp.Code, resp.Codespace, resp.RawLog)
+	}
+
+	s.sequence++
+	return resp, nil
 }
 
-// prepareFactory ensures the account defined by ctx.GetFromAddress() exists and
-// if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory. A new Factory with
-// the updated fields will be returned.
-func prepareFactory(clientCtx client.Context, txf tx.Factory) (tx.Factory, error) {
+// WaitForTx queries the node for the transaction of the given hash, backing off
+// between queries, until it is included in a block or the chain goes past
+// maxHeight. It returns the DeliverTx response of the transaction.
+func (s *TxSubmitter) WaitForTx(ctx context.Context, txHash string, maxHeight int64) (*sdk.TxResponse, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hash %s: %w", txHash, err)
+	}
+
+	backoff := txPollInitialBackoff
+	for {
+		_, clientCtx := s.clientContext()
+
+		resultTx, err := clientCtx.Client.Tx(ctx, hash, false)
+		if err == nil {
+			return sdk.NewResponseResultTx(resultTx, nil, ""), nil
+		}
+
+		height, err := s.chainHeight.GetChainHeight()
+		if err != nil {
+			return nil, err
+		}
+		if height > maxHeight {
+			return nil, fmt.Errorf("tx %s not included by height %d", txHash, maxHeight)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > txPollMaxBackoff {
+			backoff = txPollMaxBackoff
+		}
+	}
+}
+
+// sync queries the account number and sequence of the feeder account.
+func (s *TxSubmitter) sync(clientCtx client.Context) error {
 	from := clientCtx.GetFromAddress()
 
-	if err := txf.AccountRetriever().EnsureExists(clientCtx, from); err != nil {
-		return txf, err
+	if err := s.txf.AccountRetriever().EnsureExists(clientCtx, from); err != nil {
+		return err
+	}
+
+	num, seq, err := s.txf.AccountRetriever().GetAccountNumberSequence(clientCtx, from)
+	if err != nil {
+		return err
 	}
 
-	initNum, initSeq := txf.AccountNumber(), txf.Sequence()
-	if initNum == 0 || initSeq == 0 {
-		num, seq, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, from)
+	s.logger.Debug().
+		Uint64("sequence", seq).
+		Uint64("local_sequence", s.sequence).
+		Msg("synced account sequence")
+
+	s.accountNumber = num
+	s.sequence = seq
+	s.synced = true
+
+	return nil
+}
+
+// BuildTx attempts to generate and sign a transaction with the given set of
+// messages, simulating its gas requirements unless the factory has a fixed gas.
+// The account number and sequence must be set on the given factory.
+//
+// Oracle transactions are exempt from fees as long as their gas does not exceed
+// ante.MaxOracleMsgGasUsage per message. The simulated gas is adjusted up to that
+// limit at most, and a transaction exceeding it is only built if the factory has
+// gas prices to pay its fees.
+//
+// Note, BuildTx is copied from the SDK's BroadcastTx except it removes a few
+// unnecessary things like prompting for confirmation, and leaves broadcasting to
+// the caller. The transaction is signed by the given signer rather than by a
+// keyring.
+func BuildTx(
+	ctx context.Context,
+	clientCtx client.Context,
+	txf tx.Factory,
+	signer Signer,
+	msgs ...sdk.Msg,
+) ([]byte, error) {
+	maxGas := uint64(len(msgs)) * ante.MaxOracleMsgGasUsage
+
+	if txf.SimulateAndExecute() {
+		simRes, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
 		if err != nil {
-			return txf, err
+			return nil, err
 		}
 
-		if initNum == 0 {
-			txf = txf.WithAccountNumber(num)
+		if adjusted > maxGas && simRes.GasInfo.GasUsed <= maxGas {
+			adjusted = maxGas
 		}
 
-		if initSeq == 0 {
-			txf = txf.WithSequence(seq)
-		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	if txf.Gas() > maxGas && txf.GasPrices().IsZero() && txf.Fees().IsZero() {
+		return nil, fmt.Errorf(
+			"tx gas %d exceeds the maximum gas of oracle txs exempt from fees: %d", txf.Gas(), maxGas,
+		)
+	}
+
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	// unsignedTx.SetFeePayer(clientCtx.GetFeePayerAddress())
+
+	if err = signTx(ctx, clientCtx.TxConfig, txf, unsignedTx, signer); err != nil {
+		return nil, err
 	}
 
-	return txf, nil
+	return clientCtx.TxConfig.TxEncoder()(unsignedTx.GetTx())
 }
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// txClient is a Tendermint RPC client only knowing about a single tx, which
+// is found after a number of queries.
+type txClient struct {
+	tmrpcclient.Client
+
+	hash     []byte
+	height   int64
+	code     uint32
+	notFound int
+	queries  int
+}
+
+func (c *txClient) Tx(_ context.Context, hash []byte, _ bool) (*tmctypes.ResultTx, error) {
+	c.queries++
+	if c.queries <= c.notFound || hex.EncodeToString(hash) != hex.EncodeToString(c.hash) {
+		return nil, errors.New("tx not found")
+	}
+
+	return &tmctypes.ResultTx{
+		Hash:     tmbytes.HexBytes(c.hash),
+		Height:   c.height,
+		TxResult: abci.ResponseDeliverTx{Code: c.code},
+	}, nil
+}
+
+func TestTxSubmitter_WaitForTx(t *testing.T) {
+	hash := []byte{0x01, 0x02, 0x03}
+	chainHeight := &ChainHeight{
+		Logger:     zerolog.Nop(),
+		lastHeight: 10,
+		newBlock:   make(chan struct{}),
+	}
+
+	tmClient := &txClient{hash: hash, height: 11, code: 5, notFound: 2}
+	submitter := NewTxSubmitter(zerolog.Nop(), client.Context{Client: tmClient}, tx.Factory{}, nil, chainHeight, nil)
+
+	// the tx is found after backing off twice, along with its DeliverTx code
+	resp, err := submitter.WaitForTx(context.Background(), hex.EncodeToString(hash), 12)
+	require.NoError(t, err)
+	require.Equal(t, 3, tmClient.queries)
+	require.Equal(t, int64(11), resp.Height)
+	require.Equal(t, uint32(5), resp.Code)
+
+	// unknown txs are given up on once the chain goes past the max height
+	_, err = submitter.WaitForTx(context.Background(), "0a0b0c", 9)
+	require.Error(t, err)
+
+	_, err = submitter.WaitForTx(context.Background(), "invalid", 12)
+	require.Error(t, err)
+}
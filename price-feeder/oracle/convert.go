@@ -0,0 +1,179 @@
+package oracle
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+type (
+	// pairProviderPrices defines a mapping of provider => {<pair> => <TickerPrice>, ...}.
+	pairProviderPrices map[string]map[types.CurrencyPair]provider.TickerPrice
+
+	// pairProviderCandles defines a mapping of provider => {<pair> => []<CandlePrice>, ...}.
+	pairProviderCandles map[string]map[types.CurrencyPair][]provider.CandlePrice
+)
+
+// convertToUSD converts the prices and candles of every currency pair into USD
+// and aggregates them by base, as expected by the deviation filters and the
+// (T)VWAP computations. Bases are converted in conversion order, so the rate of
+// a quote, e.g. USDT, is computed from its own converted prices before the
+// pairs quoted in it, e.g. ATOM/USDT, are converted. Pairs whose quote rate is
+// not available are dropped. The USD rates of the quotes are returned as well,
+// keyed by upper cased denom.
+func (o *Oracle) convertToUSD(
+	pairs []types.CurrencyPair,
+	prices pairProviderPrices,
+	candles pairProviderCandles,
+) (provider.AggregatedProviderPrices, provider.AggregatedProviderCandles, map[string]sdk.Dec, error) {
+	order, err := types.ConversionOrder(pairs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		usdPrices  = make(provider.AggregatedProviderPrices)
+		usdCandles = make(provider.AggregatedProviderCandles)
+		rates      = map[string]sdk.Dec{types.DenomUSD: sdk.OneDec()}
+	)
+
+	for _, base := range order {
+		basePrices := make(provider.AggregatedProviderPrices)
+		baseCandles := make(provider.AggregatedProviderCandles)
+
+		for _, cp := range pairs {
+			if strings.ToUpper(cp.Base) != base {
+				continue
+			}
+
+			rate, ok := rates[strings.ToUpper(cp.Quote)]
+			if !ok {
+				o.logger.Warn().
+					Str("pair", cp.String()).
+					Str("quote", cp.Quote).
+					Msg("unable to convert pair to USD, missing quote rate")
+				continue
+			}
+
+			for providerName, providerPrices := range prices {
+				tp, ok := providerPrices[cp]
+				if !ok {
+					continue
+				}
+
+				if _, ok := basePrices[providerName]; !ok {
+					basePrices[providerName] = make(map[string]provider.TickerPrice)
+				}
+				basePrices[providerName][cp.Base] = mergeTickerPrices(
+					basePrices[providerName][cp.Base],
+					provider.TickerPrice{Price: tp.Price.Mul(rate), Volume: tp.Volume},
+				)
+			}
+
+			for providerName, providerCandles := range candles {
+				cs, ok := providerCandles[cp]
+				if !ok {
+					continue
+				}
+
+				if _, ok := baseCandles[providerName]; !ok {
+					baseCandles[providerName] = make(map[string][]provider.CandlePrice)
+				}
+				for _, c := range cs {
+					c.Price = c.Price.Mul(rate)
+					baseCandles[providerName][cp.Base] = append(baseCandles[providerName][cp.Base], c)
+				}
+			}
+		}
+
+		rate, ok, err := o.computeRate(basePrices, baseCandles)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if ok {
+			rates[base] = rate
+		}
+
+		for providerName, providerPrices := range basePrices {
+			if _, ok := usdPrices[providerName]; !ok {
+				usdPrices[providerName] = make(map[string]provider.TickerPrice)
+			}
+			for b, tp := range providerPrices {
+				usdPrices[providerName][b] = tp
+			}
+		}
+		for providerName, providerCandles := range baseCandles {
+			if _, ok := usdCandles[providerName]; !ok {
+				usdCandles[providerName] = make(map[string][]provider.CandlePrice)
+			}
+			for b, cs := range providerCandles {
+				usdCandles[providerName][b] = cs
+			}
+		}
+	}
+
+	return usdPrices, usdCandles, rates, nil
+}
+
+// computeRate computes the USD rate of a single base the same way exchange
+// rates are computed: the TVWAP of the candles within the deviation thresholds
+// if there is any, and the VWAP of the tickers within the deviation thresholds
+// otherwise. No rate is returned if fewer providers than required agree on it.
+func (o *Oracle) computeRate(
+	prices provider.AggregatedProviderPrices,
+	candles provider.AggregatedProviderCandles,
+) (sdk.Dec, bool, error) {
+	filteredCandles, err := o.filterCandleDeviations(candles)
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+
+	rates, err := ComputeTVWAP(filteredCandles, o.tvwapWindow)
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+	numProviders := len(filteredCandles)
+
+	if len(rates) == 0 {
+		filteredPrices, err := o.filterTickerDeviations(prices)
+		if err != nil {
+			return sdk.Dec{}, false, err
+		}
+
+		rates, err = ComputeVWAP(filteredPrices)
+		if err != nil {
+			return sdk.Dec{}, false, err
+		}
+		numProviders = len(filteredPrices)
+	}
+
+	for base, rate := range rates {
+		if !o.hasAgreement(base, numProviders) {
+			return sdk.Dec{}, false, nil
+		}
+		return rate, true, nil
+	}
+
+	return sdk.Dec{}, false, nil
+}
+
+// mergeTickerPrices merges the ticker prices of a base quoted in different
+// currencies by the same provider, weighting their prices by volume.
+func mergeTickerPrices(a, b provider.TickerPrice) provider.TickerPrice {
+	if a.Price.IsNil() {
+		return b
+	}
+
+	volume := a.Volume.Add(b.Volume)
+	if !volume.IsPositive() {
+		return a
+	}
+
+	return provider.TickerPrice{
+		Price:  a.Price.Mul(a.Volume).Add(b.Price.Mul(b.Volume)).Quo(volume),
+		Volume: volume,
+	}
+}
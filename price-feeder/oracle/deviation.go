@@ -0,0 +1,117 @@
+package oracle
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/config"
+)
+
+type (
+	// deviationFilter defines how the prices of a base deviating from the other
+	// providers are filtered out, and how many providers must agree on a price
+	// for the base to be voted on.
+	deviationFilter struct {
+		strategy     string
+		threshold    sdk.Dec
+		minProviders int
+	}
+
+	// priceBounds defines the range of prices accepted for a base.
+	priceBounds struct {
+		lower sdk.Dec
+		upper sdk.Dec
+	}
+)
+
+// defaultDeviationFilter is used for the bases without configured deviation
+// thresholds.
+var defaultDeviationFilter = deviationFilter{
+	strategy:  config.DeviationStrategySigma,
+	threshold: deviationThreshold,
+}
+
+// newDeviationFilters returns the configured deviation filters keyed by upper
+// cased base. The deviations are expected to be validated by the config.
+func newDeviationFilters(deviations []config.Deviation) map[string]deviationFilter {
+	filters := make(map[string]deviationFilter, len(deviations))
+
+	for _, d := range deviations {
+		filter := defaultDeviationFilter
+		if len(d.Strategy) != 0 {
+			filter.strategy = d.Strategy
+		}
+		if len(d.Threshold) != 0 {
+			filter.threshold = sdk.MustNewDecFromStr(d.Threshold)
+		}
+		filter.minProviders = d.MinProviders
+
+		filters[strings.ToUpper(d.Base)] = filter
+	}
+
+	return filters
+}
+
+// deviationFilter returns the deviation filter of the given base.
+func (o *Oracle) deviationFilter(base string) deviationFilter {
+	o.providerMtx.Lock()
+	defer o.providerMtx.Unlock()
+
+	if filter, ok := o.deviationFilters[strings.ToUpper(base)]; ok {
+		return filter
+	}
+
+	return defaultDeviationFilter
+}
+
+// deviationBounds returns the range of accepted prices of every base according
+// to its deviation filter. The provided prices argument reflects a mapping of
+// provider => {<base> => <price>, ...}. Bases for which the deviation cannot be
+// computed have no bounds, so that all their prices are accepted.
+func (o *Oracle) deviationBounds(prices map[string]map[string]sdk.Dec) (map[string]priceBounds, error) {
+	deviations, means, err := StandardDeviation(prices)
+	if err != nil {
+		return nil, err
+	}
+
+	medianDeviations, medians := MedianDeviation(prices)
+
+	bounds := make(map[string]priceBounds)
+	for base, median := range medians {
+		filter := o.deviationFilter(base)
+
+		switch filter.strategy {
+		case config.DeviationStrategySigma:
+			if deviation, ok := deviations[base]; ok {
+				bounds[base] = newPriceBounds(means[base], deviation.Mul(filter.threshold))
+			}
+
+		case config.DeviationStrategyMAD:
+			bounds[base] = newPriceBounds(median, medianDeviations[base].Mul(filter.threshold))
+
+		case config.DeviationStrategyPercent:
+			bounds[base] = newPriceBounds(median, median.Mul(filter.threshold).QuoInt64(100))
+		}
+	}
+
+	return bounds, nil
+}
+
+// hasAgreement returns true if the price of the given base is agreed on by
+// enough providers to be voted on.
+func (o *Oracle) hasAgreement(base string, numProviders int) bool {
+	return numProviders >= o.deviationFilter(base).minProviders
+}
+
+func newPriceBounds(center, delta sdk.Dec) priceBounds {
+	return priceBounds{
+		lower: center.Sub(delta),
+		upper: center.Add(delta),
+	}
+}
+
+// contains returns true if the given price is within the bounds.
+func (b priceBounds) contains(price sdk.Dec) bool {
+	return price.GTE(b.lower) && price.LTE(b.upper)
+}
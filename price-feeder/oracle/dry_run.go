@@ -0,0 +1,157 @@
+package oracle
+
+import (
+	"strings"
+
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/telemetry"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+type (
+	// dryRunState defines the pre-vote and vote the oracle would have broadcast
+	// in dry-run mode.
+	dryRunState struct {
+		votePeriod      int64
+		prevote         *dryRunVote
+		vote            *dryRunVote
+		lastTallyHeight int64
+	}
+
+	// dryRunVote defines the prices the oracle would have committed to in a
+	// pre-vote, and revealed in a vote during the next voting period.
+	dryRunVote struct {
+		votePeriod    int64
+		exchangeRates string
+		prices        map[string]sdk.Dec
+	}
+
+	// TallyComparison defines the deviation of the prices the oracle would have
+	// voted on from the exchange rates tallied on-chain at Height. Deviations
+	// are relative to the on-chain rates, e.g. 0.01 for 1%, and only reported
+	// for the denoms with both a price and an on-chain rate.
+	TallyComparison struct {
+		Height        int64              `json:"height"`
+		ExchangeRates map[string]sdk.Dec `json:"exchange_rates"`
+		OnChainRates  map[string]sdk.Dec `json:"on_chain_rates"`
+		Deviations    map[string]sdk.Dec `json:"deviations"`
+	}
+)
+
+// dryRunTick computes the pre-vote and vote the oracle would broadcast at the
+// given height, and compares the prices of the last vote with the exchange
+// rates tallied on-chain once its voting period ends. Nothing is signed or
+// broadcast.
+func (o *Oracle) dryRunTick(blockHeight int64, params oracletypes.Params) error {
+	votePeriod := int64(params.VotePeriod)
+	nextBlockHeight := blockHeight + 1
+	currentVotePeriod := nextBlockHeight / votePeriod
+
+	// the votes of a voting period are tallied in its last block, so the vote
+	// is compared before the next one replaces it
+	if err := o.compareTally(currentVotePeriod*votePeriod-1, votePeriod); err != nil {
+		o.logger.Err(err).Msg("dry run: failed to compare prices with on-chain exchange rates")
+	}
+
+	if currentVotePeriod == o.dryRunState.votePeriod {
+		return nil
+	}
+
+	// the pre-vote of the previous voting period is revealed in this one
+	if prevote := o.dryRunState.prevote; prevote != nil && prevote.votePeriod == currentVotePeriod-1 {
+		o.logger.Info().
+			Str("exchange_rates", prevote.exchangeRates).
+			Msg("dry run: would broadcast vote")
+
+		o.dryRunState.vote = prevote
+		o.setVoteResult(newTxResult("", prevote.exchangeRates, nextBlockHeight, nil, nil))
+	}
+
+	prices := o.GetPrices()
+	prevote := &dryRunVote{
+		votePeriod:    currentVotePeriod,
+		exchangeRates: GenerateExchangeRatesString(prices),
+		prices:        prices,
+	}
+
+	o.logger.Info().
+		Str("exchange_rates", prevote.exchangeRates).
+		Msg("dry run: would broadcast pre-vote")
+
+	o.dryRunState.prevote = prevote
+	o.dryRunState.votePeriod = currentVotePeriod
+	o.setPrevoteResult(newTxResult("", prevote.exchangeRates, nextBlockHeight, nil, nil))
+
+	return nil
+}
+
+// compareTally compares the prices of the last vote with the on-chain exchange
+// rates, if the vote was tallied at the given height and not compared yet.
+func (o *Oracle) compareTally(tallyHeight, votePeriod int64) error {
+	vote := o.dryRunState.vote
+	if vote == nil || tallyHeight <= o.dryRunState.lastTallyHeight || tallyHeight/votePeriod != vote.votePeriod+1 {
+		return nil
+	}
+
+	exchangeRates, err := o.GetExchangeRates()
+	if err != nil {
+		return err
+	}
+
+	o.dryRunState.lastTallyHeight = tallyHeight
+
+	comparison := compareExchangeRates(tallyHeight, vote.prices, exchangeRates)
+	for denom, deviation := range comparison.Deviations {
+		o.logger.Info().
+			Str("denom", denom).
+			Str("price", comparison.ExchangeRates[denom].String()).
+			Str("on_chain_rate", comparison.OnChainRates[denom].String()).
+			Str("deviation", deviation.String()).
+			Int64("tally_height", tallyHeight).
+			Msg("dry run: deviation from on-chain exchange rate")
+
+		telemetry.SetGaugeWithLabels(
+			[]string{"dry_run", "deviation"},
+			float32(deviation.MustFloat64()),
+			[]metrics.Label{telemetry.NewLabel("denom", denom)},
+		)
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.voteStatus.Tally = &comparison
+	return nil
+}
+
+// compareExchangeRates returns the relative deviations of the given prices from
+// the given on-chain exchange rates, tallied at the given height.
+func compareExchangeRates(
+	height int64,
+	prices map[string]sdk.Dec,
+	exchangeRates sdk.DecCoins,
+) TallyComparison {
+	comparison := TallyComparison{
+		Height:        height,
+		ExchangeRates: prices,
+		OnChainRates:  make(map[string]sdk.Dec, len(exchangeRates)),
+		Deviations:    make(map[string]sdk.Dec),
+	}
+
+	for _, rate := range exchangeRates {
+		comparison.OnChainRates[strings.ToUpper(rate.Denom)] = rate.Amount
+	}
+
+	for denom, price := range prices {
+		rate, ok := comparison.OnChainRates[denom]
+		if !ok || !rate.IsPositive() {
+			continue
+		}
+
+		comparison.Deviations[denom] = price.Sub(rate).Abs().Quo(rate)
+	}
+
+	return comparison
+}
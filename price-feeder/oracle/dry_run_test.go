@@ -0,0 +1,69 @@
+package oracle
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/oracle/client"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+func TestDryRunTick(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+			DryRun:       true,
+		},
+	)
+	params := oracletypes.Params{VotePeriod: 5}
+
+	oracle.prices = map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("34.84")}
+	require.NoError(t, oracle.dryRunTick(9, params))
+
+	status := oracle.GetVoteStatus()
+	require.True(t, status.DryRun)
+	require.Equal(t, "ATOM:34.840000000000000000", status.Prevote.ExchangeRates)
+	require.Equal(t, int64(10), status.Prevote.BlockHeight)
+	require.Nil(t, status.Vote)
+
+	// nothing is computed again within the same voting period
+	oracle.prices = map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("35.12")}
+	require.NoError(t, oracle.dryRunTick(12, params))
+	require.Equal(t, int64(10), oracle.GetVoteStatus().Prevote.BlockHeight)
+
+	// the pre-vote is revealed in the next voting period
+	require.NoError(t, oracle.dryRunTick(14, params))
+
+	status = oracle.GetVoteStatus()
+	require.Equal(t, "ATOM:34.840000000000000000", status.Vote.ExchangeRates)
+	require.Equal(t, "ATOM:35.120000000000000000", status.Prevote.ExchangeRates)
+	require.Equal(t, int64(15), status.Prevote.BlockHeight)
+	require.Nil(t, status.Tally)
+}
+
+func TestCompareExchangeRates(t *testing.T) {
+	prices := map[string]sdk.Dec{
+		"ATOM": sdk.MustNewDecFromStr("33"),
+		"UMEE": sdk.MustNewDecFromStr("0.05"),
+		"JUNO": sdk.MustNewDecFromStr("10"),
+	}
+	exchangeRates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ATOM", sdk.MustNewDecFromStr("30")),
+		sdk.NewDecCoinFromDec("UMEE", sdk.MustNewDecFromStr("0.05")),
+	)
+
+	comparison := compareExchangeRates(19, prices, exchangeRates)
+	require.Equal(t, int64(19), comparison.Height)
+	require.Equal(t, prices, comparison.ExchangeRates)
+	require.Equal(t, sdk.MustNewDecFromStr("30"), comparison.OnChainRates["ATOM"])
+	require.Equal(t, map[string]sdk.Dec{
+		"ATOM": sdk.MustNewDecFromStr("0.1"),
+		"UMEE": sdk.ZeroDec(),
+	}, comparison.Deviations)
+}
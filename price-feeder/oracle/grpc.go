@@ -2,10 +2,23 @@ package oracle
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/umee-network/umee/price-feeder/oracle/client"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
 )
 
+// grpcQueryTimeout defines the timeout of the x/oracle gRPC queries.
+const grpcQueryTimeout = 15 * time.Second
+
 func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
 	return Connect(addr)
 }
@@ -32,3 +45,84 @@ func ProtocolAndAddress(listenAddr string) (string, string) {
 
 	return protocol, address
 }
+
+// queryWithFailover runs the given x/oracle query against the active node. If
+// the node cannot be reached, the node pool fails over and the query is run
+// once more against the new active node.
+func (o *Oracle) queryWithFailover(
+	query func(ctx context.Context, queryClient oracletypes.QueryClient) error,
+) error {
+	node := o.oracleClient.Nodes.Active()
+
+	err := queryNode(node, query)
+	if err == nil || !isUnavailable(err) {
+		return err
+	}
+
+	next := o.oracleClient.Nodes.Failover(node)
+	if next == node {
+		return err
+	}
+
+	o.logger.Warn().
+		Err(err).
+		Str("grpc", node.GRPC).
+		Str("next_grpc", next.GRPC).
+		Msg("query failed; retrying on another node")
+
+	return queryNode(next, query)
+}
+
+// queryNode runs the given x/oracle query against the gRPC endpoint of the
+// given node.
+func queryNode(
+	node *client.Node,
+	query func(ctx context.Context, queryClient oracletypes.QueryClient) error,
+) error {
+	grpcConn, err := grpc.Dial(
+		node.GRPC,
+		// the Cosmos SDK doesn't support any transport security mechanism
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialerFunc),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+	}
+
+	defer grpcConn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), grpcQueryTimeout)
+	defer cancel()
+
+	return query(ctx, oracletypes.NewQueryClient(grpcConn))
+}
+
+// isUnavailable returns true if the given gRPC error reflects a node that
+// cannot be reached or does not respond in time, rather than a failed query.
+func isUnavailable(err error) bool {
+	switch grpcCode(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// isNotFound returns true if the given gRPC error reflects a query for an
+// object that does not exist. The x/oracle module reports missing objects with
+// its own errors, which reach the client with an unknown code.
+func isNotFound(err error, notFoundErr error) bool {
+	return grpcCode(err) == codes.NotFound || strings.Contains(err.Error(), notFoundErr.Error())
+}
+
+// grpcCode returns the code of the gRPC status of the given error, which may
+// be wrapped.
+func grpcCode(err error) codes.Code {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return grpcErr.GRPCStatus().Code()
+	}
+
+	return status.Code(err)
+}
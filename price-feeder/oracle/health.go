@@ -0,0 +1,460 @@
+package oracle
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/telemetry"
+)
+
+const (
+	// healthHistorySize defines the number of latest evaluations of a provider
+	// pair over which its deviations are counted.
+	healthHistorySize = 20
+
+	// quarantineThreshold defines the number of consecutive failures or
+	// deviations after which a provider pair is quarantined.
+	quarantineThreshold = 3
+
+	// quarantineBackoff defines how long a provider pair is quarantined at
+	// first. The quarantine is doubled every time the pair still fails or
+	// deviates once it ends, up to maxQuarantineBackoff.
+	quarantineBackoff    = 1 * time.Minute
+	maxQuarantineBackoff = 1 * time.Hour
+
+	// providerStaleTimeout defines the time after which a provider pair that
+	// was not updated is considered stale.
+	providerStaleTimeout = 5 * time.Minute
+)
+
+// ProviderHealth defines the health of a currency pair of a provider. A pair
+// that fails or deviates from the other providers too many times in a row is
+// quarantined, so that its prices are not used, until it agrees with the other
+// providers again once the quarantine ends.
+type ProviderHealth struct {
+	// Score ranges from 0, for a stale pair, to 1 for a pair that neither
+	// failed nor deviated recently.
+	Score float64 `json:"score"`
+
+	ConsecutiveFailures   int       `json:"consecutive_failures"`
+	ConsecutiveDeviations int       `json:"consecutive_deviations"`
+	Deviations            int       `json:"deviations"`
+	LastError             string    `json:"last_error,omitempty"`
+	LastUpdate            time.Time `json:"last_update"`
+	Stale                 bool      `json:"stale"`
+
+	Quarantined      bool      `json:"quarantined"`
+	QuarantinedUntil time.Time `json:"quarantined_until"`
+	Quarantines      int       `json:"quarantines"`
+
+	// history reflects whether the pair deviated in each of its latest
+	// successful evaluations.
+	history []bool
+}
+
+// record updates the health of the pair with the outcome of its evaluation at
+// the given time, which either failed with err, or succeeded and possibly
+// deviated from the other providers.
+func (h *ProviderHealth) record(now time.Time, err error, deviated bool) {
+	if err != nil {
+		h.ConsecutiveFailures++
+		h.LastError = err.Error()
+	} else {
+		h.ConsecutiveFailures = 0
+		h.LastError = ""
+		h.LastUpdate = now
+
+		if deviated {
+			h.ConsecutiveDeviations++
+		} else {
+			h.ConsecutiveDeviations = 0
+		}
+
+		h.history = append(h.history, deviated)
+		if len(h.history) > healthHistorySize {
+			h.history = h.history[len(h.history)-healthHistorySize:]
+		}
+	}
+
+	h.Deviations = 0
+	for _, d := range h.history {
+		if d {
+			h.Deviations++
+		}
+	}
+
+	h.Stale = now.Sub(h.LastUpdate) > providerStaleTimeout
+	healthy := err == nil && !deviated
+
+	switch {
+	case !h.Quarantined:
+		if h.ConsecutiveFailures >= quarantineThreshold || h.ConsecutiveDeviations >= quarantineThreshold {
+			h.Quarantined = true
+			h.Quarantines = 1
+			h.QuarantinedUntil = now.Add(quarantineDuration(h.Quarantines))
+		}
+
+	case now.Before(h.QuarantinedUntil):
+		// the pair is not evaluated for readmission before its quarantine ends
+
+	case healthy:
+		h.Quarantined = false
+		h.Quarantines = 0
+		h.QuarantinedUntil = time.Time{}
+
+	default:
+		h.Quarantines++
+		h.QuarantinedUntil = now.Add(quarantineDuration(h.Quarantines))
+	}
+
+	h.Score = h.score()
+}
+
+// score returns the health score of the pair, which is the share of its latest
+// evaluations it did not deviate in, divided by one more than its consecutive
+// failures.
+func (h *ProviderHealth) score() float64 {
+	if h.Stale {
+		return 0
+	}
+
+	score := 1.0
+	if len(h.history) > 0 {
+		score = 1 - float64(h.Deviations)/float64(len(h.history))
+	}
+
+	return score / float64(1+h.ConsecutiveFailures)
+}
+
+// quarantineDuration returns the duration of the given consecutive quarantine.
+func quarantineDuration(quarantines int) time.Duration {
+	d := quarantineBackoff
+	for i := 1; i < quarantines; i++ {
+		d *= 2
+		if d >= maxQuarantineBackoff {
+			return maxQuarantineBackoff
+		}
+	}
+
+	return d
+}
+
+// GetProviderHealth returns a copy of the health of every provider pair,
+// keyed by provider and pair symbol.
+func (o *Oracle) GetProviderHealth() map[string]map[string]ProviderHealth {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	health := make(map[string]map[string]ProviderHealth, len(o.providerHealth))
+	for providerName, pairs := range o.providerHealth {
+		health[providerName] = make(map[string]ProviderHealth, len(pairs))
+		for pair, h := range pairs {
+			health[providerName][pair] = *h
+		}
+	}
+
+	return health
+}
+
+// quarantinedPairs returns the symbols of the quarantined pairs of every
+// provider.
+func (o *Oracle) quarantinedPairs() map[string]map[string]struct{} {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	quarantined := make(map[string]map[string]struct{})
+	for providerName, pairs := range o.providerHealth {
+		for pair, h := range pairs {
+			if !h.Quarantined {
+				continue
+			}
+			if _, ok := quarantined[providerName]; !ok {
+				quarantined[providerName] = make(map[string]struct{})
+			}
+			quarantined[providerName][pair] = struct{}{}
+		}
+	}
+
+	return quarantined
+}
+
+// updateProviderHealth records the outcome of fetching the given pairs from
+// every provider. A pair fails if its provider returned an error, or if neither
+// a price nor candles were returned for it. A pair that is not quarantined
+// deviates if the ticker or candles of its base were filtered out, while a
+// quarantined pair deviates unless it is one of the agreeing pairs. Pairs no
+// longer fetched are forgotten.
+func (o *Oracle) updateProviderHealth(
+	now time.Time,
+	providerPairs map[string][]types.CurrencyPair,
+	providerErrs map[string]error,
+	pairPrices pairProviderPrices,
+	pairCandles pairProviderCandles,
+	deviatingTickers, deviatingCandles map[string][]string,
+	agreeingPairs map[string]map[types.CurrencyPair]struct{},
+) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	health := make(map[string]map[string]*ProviderHealth, len(providerPairs))
+
+	for providerName, pairs := range providerPairs {
+		deviating := make(map[string]struct{})
+		for _, base := range deviatingTickers[providerName] {
+			deviating[base] = struct{}{}
+		}
+		for _, base := range deviatingCandles[providerName] {
+			deviating[base] = struct{}{}
+		}
+
+		health[providerName] = make(map[string]*ProviderHealth, len(pairs))
+		for _, pair := range pairs {
+			h, ok := o.providerHealth[providerName][pair.String()]
+			if !ok {
+				h = &ProviderHealth{}
+			}
+			health[providerName][pair.String()] = h
+
+			err := providerErrs[providerName]
+			if err == nil {
+				_, pricesOk := pairPrices[providerName][pair]
+				_, candlesOk := pairCandles[providerName][pair]
+				if !pricesOk && !candlesOk {
+					err = fmt.Errorf("no exchange rate for %s", pair)
+				}
+			}
+
+			_, deviated := deviating[pair.Base]
+			if h.Quarantined {
+				_, agreeing := agreeingPairs[providerName][pair]
+				deviated = !agreeing
+			}
+
+			wasQuarantined := h.Quarantined
+			h.record(now, err, deviated)
+
+			logger := o.logger.With().Str("provider", providerName).Str("pair", pair.String()).Logger()
+			switch {
+			case h.Quarantined && !wasQuarantined:
+				logger.Warn().
+					Int("consecutive_failures", h.ConsecutiveFailures).
+					Int("consecutive_deviations", h.ConsecutiveDeviations).
+					Time("until", h.QuarantinedUntil).
+					Msg("quarantining provider pair")
+
+			case !h.Quarantined && wasQuarantined:
+				logger.Info().Msg("readmitting provider pair")
+			}
+
+			reportProviderHealth(providerName, pair.String(), h)
+		}
+	}
+
+	o.providerHealth = health
+}
+
+// reportProviderHealth sets the health gauges of the given provider pair.
+func reportProviderHealth(providerName, pair string, h *ProviderHealth) {
+	labels := []metrics.Label{
+		telemetry.NewLabel("provider", providerName),
+		telemetry.NewLabel("pair", pair),
+	}
+
+	var quarantined float32
+	if h.Quarantined {
+		quarantined = 1
+	}
+
+	telemetry.SetGaugeWithLabels([]string{"provider", "health", "score"}, float32(h.Score), labels)
+	telemetry.SetGaugeWithLabels([]string{"provider", "health", "quarantined"}, quarantined, labels)
+	telemetry.SetGaugeWithLabels(
+		[]string{"provider", "health", "consecutive_failures"},
+		float32(h.ConsecutiveFailures),
+		labels,
+	)
+	telemetry.SetGaugeWithLabels([]string{"provider", "health", "deviations"}, float32(h.Deviations), labels)
+}
+
+// splitQuarantinedPrices splits the given prices into the prices of the pairs
+// that are not quarantined, and the prices of the quarantined pairs.
+func splitQuarantinedPrices(
+	prices pairProviderPrices,
+	quarantined map[string]map[string]struct{},
+) (pairProviderPrices, pairProviderPrices) {
+	active := make(pairProviderPrices, len(prices))
+	quarantinedPrices := make(pairProviderPrices)
+
+	for providerName, providerPrices := range prices {
+		active[providerName] = make(map[types.CurrencyPair]provider.TickerPrice, len(providerPrices))
+		for pair, tp := range providerPrices {
+			if _, ok := quarantined[providerName][pair.String()]; !ok {
+				active[providerName][pair] = tp
+				continue
+			}
+
+			if _, ok := quarantinedPrices[providerName]; !ok {
+				quarantinedPrices[providerName] = make(map[types.CurrencyPair]provider.TickerPrice)
+			}
+			quarantinedPrices[providerName][pair] = tp
+		}
+	}
+
+	return active, quarantinedPrices
+}
+
+// splitQuarantinedCandles splits the given candles into the candles of the
+// pairs that are not quarantined, and the candles of the quarantined pairs.
+func splitQuarantinedCandles(
+	candles pairProviderCandles,
+	quarantined map[string]map[string]struct{},
+) (pairProviderCandles, pairProviderCandles) {
+	active := make(pairProviderCandles, len(candles))
+	quarantinedCandles := make(pairProviderCandles)
+
+	for providerName, providerCandles := range candles {
+		active[providerName] = make(map[types.CurrencyPair][]provider.CandlePrice, len(providerCandles))
+		for pair, cs := range providerCandles {
+			if _, ok := quarantined[providerName][pair.String()]; !ok {
+				active[providerName][pair] = cs
+				continue
+			}
+
+			if _, ok := quarantinedCandles[providerName]; !ok {
+				quarantinedCandles[providerName] = make(map[types.CurrencyPair][]provider.CandlePrice)
+			}
+			quarantinedCandles[providerName][pair] = cs
+		}
+	}
+
+	return active, quarantinedCandles
+}
+
+// agreeingQuarantinedPairs returns the quarantined pairs of every provider
+// whose ticker and candles, converted to USD with the given rates, are within
+// the deviation thresholds of the filtered prices and candles of the other
+// providers, as if they were filtered along with them. Pairs whose quote rate
+// is not available cannot agree.
+func (o *Oracle) agreeingQuarantinedPairs(
+	rates map[string]sdk.Dec,
+	quarantinedPrices pairProviderPrices,
+	quarantinedCandles pairProviderCandles,
+	filteredPrices provider.AggregatedProviderPrices,
+	filteredCandles provider.AggregatedProviderCandles,
+) (map[string]map[types.CurrencyPair]struct{}, error) {
+	tickerPrices := make(map[string]map[string]sdk.Dec)
+	for providerName, prices := range filteredPrices {
+		tickerPrices[providerName] = make(map[string]sdk.Dec, len(prices))
+		for base, tp := range prices {
+			tickerPrices[providerName][base] = tp.Price
+		}
+	}
+
+	candlePrices := make(map[string]map[string]sdk.Dec)
+	for providerName, candles := range filteredCandles {
+		tvwaps, err := ComputeTVWAP(provider.AggregatedProviderCandles{providerName: candles}, o.tvwapWindow)
+		if err != nil {
+			return nil, err
+		}
+		candlePrices[providerName] = tvwaps
+	}
+
+	pairs := make(map[string]map[types.CurrencyPair]struct{})
+	for providerName, providerPrices := range quarantinedPrices {
+		for pair := range providerPrices {
+			if _, ok := pairs[providerName]; !ok {
+				pairs[providerName] = make(map[types.CurrencyPair]struct{})
+			}
+			pairs[providerName][pair] = struct{}{}
+		}
+	}
+	for providerName, providerCandles := range quarantinedCandles {
+		for pair := range providerCandles {
+			if _, ok := pairs[providerName]; !ok {
+				pairs[providerName] = make(map[types.CurrencyPair]struct{})
+			}
+			pairs[providerName][pair] = struct{}{}
+		}
+	}
+
+	agreeing := make(map[string]map[types.CurrencyPair]struct{})
+	for providerName, providerPairs := range pairs {
+		for pair := range providerPairs {
+			rate, ok := rates[strings.ToUpper(pair.Quote)]
+			if !ok {
+				continue
+			}
+
+			if tp, ok := quarantinedPrices[providerName][pair]; ok {
+				agrees, err := o.agreesWith(tickerPrices, pair.Base, tp.Price.Mul(rate))
+				if err != nil {
+					return nil, err
+				}
+				if !agrees {
+					continue
+				}
+			}
+
+			if cs, ok := quarantinedCandles[providerName][pair]; ok {
+				usdCandles := make([]provider.CandlePrice, len(cs))
+				for i, c := range cs {
+					c.Price = c.Price.Mul(rate)
+					usdCandles[i] = c
+				}
+
+				tvwaps, err := ComputeTVWAP(
+					provider.AggregatedProviderCandles{providerName: {pair.Base: usdCandles}},
+					o.tvwapWindow,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				if tvwap, ok := tvwaps[pair.Base]; ok {
+					agrees, err := o.agreesWith(candlePrices, pair.Base, tvwap)
+					if err != nil {
+						return nil, err
+					}
+					if !agrees {
+						continue
+					}
+				}
+			}
+
+			if _, ok := agreeing[providerName]; !ok {
+				agreeing[providerName] = make(map[types.CurrencyPair]struct{})
+			}
+			agreeing[providerName][pair] = struct{}{}
+		}
+	}
+
+	return agreeing, nil
+}
+
+// agreesWith returns true if the given price of a base is within the deviation
+// bounds it would have if it was filtered along with the given prices, keyed by
+// provider and base.
+func (o *Oracle) agreesWith(prices map[string]map[string]sdk.Dec, base string, price sdk.Dec) (bool, error) {
+	basePrices := make(map[string]map[string]sdk.Dec, len(prices)+1)
+	for providerName, p := range prices {
+		if bp, ok := p[base]; ok {
+			basePrices[providerName] = map[string]sdk.Dec{base: bp}
+		}
+	}
+
+	// the key cannot collide with the name of a provider
+	basePrices[""] = map[string]sdk.Dec{base: price}
+
+	bounds, err := o.deviationBounds(basePrices)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := bounds[base]
+	return !ok || b.contains(price), nil
+}
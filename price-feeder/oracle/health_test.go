@@ -0,0 +1,233 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/config"
+	"github.com/umee-network/umee/price-feeder/oracle/client"
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+func TestProviderHealth_Quarantine(t *testing.T) {
+	now := time.Now()
+	errFailed := fmt.Errorf("failed")
+
+	var h ProviderHealth
+	h.record(now, nil, false)
+	require.Equal(t, 1.0, h.Score)
+	require.False(t, h.Stale)
+
+	h.record(now, errFailed, false)
+	require.Equal(t, 1, h.ConsecutiveFailures)
+	require.Equal(t, "failed", h.LastError)
+	require.Equal(t, 0.5, h.Score)
+
+	h.record(now, nil, true)
+	require.Equal(t, 0, h.ConsecutiveFailures)
+	require.Equal(t, 1, h.ConsecutiveDeviations)
+	require.Equal(t, 1, h.Deviations)
+	require.Equal(t, 0.5, h.Score)
+	require.False(t, h.Quarantined)
+
+	// the pair is quarantined after deviating quarantineThreshold times in a row
+	h.record(now, nil, true)
+	h.record(now, nil, true)
+	require.True(t, h.Quarantined)
+	require.Equal(t, 1, h.Quarantines)
+	require.Equal(t, now.Add(quarantineBackoff), h.QuarantinedUntil)
+
+	// agreeing again does not readmit the pair before its quarantine ends
+	now = now.Add(quarantineBackoff / 2)
+	h.record(now, nil, false)
+	require.True(t, h.Quarantined)
+
+	// the quarantine is doubled when the pair still deviates once it ends
+	now = now.Add(quarantineBackoff)
+	h.record(now, nil, true)
+	require.True(t, h.Quarantined)
+	require.Equal(t, 2, h.Quarantines)
+	require.Equal(t, now.Add(2*quarantineBackoff), h.QuarantinedUntil)
+
+	now = now.Add(2 * quarantineBackoff)
+	h.record(now, nil, false)
+	require.False(t, h.Quarantined)
+	require.Equal(t, 0, h.Quarantines)
+	require.True(t, h.QuarantinedUntil.IsZero())
+
+	// the pair becomes stale when it keeps failing
+	now = now.Add(providerStaleTimeout + time.Second)
+	h.record(now, errFailed, false)
+	require.True(t, h.Stale)
+	require.Equal(t, 0.0, h.Score)
+}
+
+func TestQuarantineDuration(t *testing.T) {
+	require.Equal(t, quarantineBackoff, quarantineDuration(1))
+	require.Equal(t, 4*quarantineBackoff, quarantineDuration(3))
+	require.Equal(t, maxQuarantineBackoff, quarantineDuration(100))
+}
+
+func TestSetPrices_Quarantine(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "ATOM",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderBinance, provider.ProviderKraken, provider.ProviderCoinbase},
+				},
+			},
+			Deviations: []config.Deviation{
+				{
+					Base:      "ATOM",
+					Strategy:  config.DeviationStrategyPercent,
+					Threshold: "5",
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	atomPrice := func(price string) mockProvider {
+		return mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr(price),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		}
+	}
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderBinance:  atomPrice("10"),
+		provider.ProviderKraken:   atomPrice("10"),
+		provider.ProviderCoinbase: atomPrice("12"),
+	}
+
+	acceptList := oracletypes.DenomList{
+		oracletypes.Denom{BaseDenom: "UATOM", SymbolDenom: "ATOM", Exponent: 6},
+	}
+
+	for i := 0; i < quarantineThreshold; i++ {
+		require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	}
+
+	health := oracle.GetProviderHealth()
+	require.True(t, health[provider.ProviderCoinbase]["ATOMUSD"].Quarantined)
+	require.Equal(t, quarantineThreshold, health[provider.ProviderCoinbase]["ATOMUSD"].Deviations)
+	require.False(t, health[provider.ProviderBinance]["ATOMUSD"].Quarantined)
+	require.Equal(t, 1.0, health[provider.ProviderBinance]["ATOMUSD"].Score)
+
+	// the quarantined provider is left out even though it agrees again
+	oracle.priceProviders[provider.ProviderCoinbase] = atomPrice("10.3")
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	require.Equal(t, sdk.MustNewDecFromStr("10"), oracle.GetPrices()["ATOM"])
+	require.True(t, oracle.GetProviderHealth()[provider.ProviderCoinbase]["ATOMUSD"].Quarantined)
+
+	// once the quarantine ends, the pair is readmitted if it agrees with the
+	// filtered prices of the other providers
+	oracle.providerHealth[provider.ProviderCoinbase]["ATOMUSD"].QuarantinedUntil = time.Now().Add(-time.Second)
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	require.False(t, oracle.GetProviderHealth()[provider.ProviderCoinbase]["ATOMUSD"].Quarantined)
+
+	// failures are recorded per provider
+	oracle.priceProviders[provider.ProviderKraken] = failingProvider{}
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	health = oracle.GetProviderHealth()
+	require.Equal(t, 1, health[provider.ProviderKraken]["ATOMUSD"].ConsecutiveFailures)
+	require.Equal(t, "unable to get ticker prices", health[provider.ProviderKraken]["ATOMUSD"].LastError)
+	require.Equal(t, 0, health[provider.ProviderBinance]["ATOMUSD"].ConsecutiveFailures)
+}
+
+func TestSetPrices_QuarantinedConversion(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "ATOM",
+					Quote:     "USDT",
+					Providers: []string{provider.ProviderBinance},
+				},
+				{
+					Base:      "USDT",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderBinance, provider.ProviderKraken, provider.ProviderCoinbase},
+				},
+			},
+			Deviations: []config.Deviation{
+				{
+					Base:      "USDT",
+					Strategy:  config.DeviationStrategyPercent,
+					Threshold: "5",
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	usdtPrice := func(price string) mockProvider {
+		return mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"USDTUSD": {
+					Price:  sdk.MustNewDecFromStr(price),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		}
+	}
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderBinance: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSDT": {
+					Price:  sdk.MustNewDecFromStr("10"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+				"USDTUSD": {
+					Price:  sdk.MustNewDecFromStr("1"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+		provider.ProviderKraken:   usdtPrice("1"),
+		provider.ProviderCoinbase: usdtPrice("2"),
+	}
+
+	acceptList := oracletypes.DenomList{
+		oracletypes.Denom{BaseDenom: "UATOM", SymbolDenom: "ATOM", Exponent: 6},
+	}
+
+	for i := 0; i < quarantineThreshold; i++ {
+		require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	}
+	require.True(t, oracle.GetProviderHealth()[provider.ProviderCoinbase]["USDTUSD"].Quarantined)
+
+	// the quarantined conversion rate is not used, even within the thresholds
+	oracle.priceProviders[provider.ProviderCoinbase] = usdtPrice("1.04")
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	require.Equal(t, sdk.MustNewDecFromStr("10"), oracle.GetPrices()["ATOM"])
+	require.True(t, oracle.GetProviderHealth()[provider.ProviderCoinbase]["USDTUSD"].Quarantined)
+
+	// it is still judged against the consensus of the other providers
+	oracle.priceProviders[provider.ProviderCoinbase] = usdtPrice("2")
+	oracle.providerHealth[provider.ProviderCoinbase]["USDTUSD"].QuarantinedUntil = time.Now().Add(-time.Second)
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	health := oracle.GetProviderHealth()[provider.ProviderCoinbase]["USDTUSD"]
+	require.True(t, health.Quarantined)
+	require.Equal(t, 2, health.Quarantines)
+}
@@ -11,11 +11,10 @@ import (
 	"sync"
 	"time"
 
-	rpcclient "github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
 
 	"github.com/umee-network/umee/price-feeder/config"
 	"github.com/umee-network/umee/price-feeder/oracle/client"
@@ -26,26 +25,32 @@ import (
 	oracletypes "github.com/umee-network/umee/x/oracle/types"
 )
 
-// We define tickerTimeout as the minimum timeout between each oracle loop. We
-// define this value empirically based on enough time to collect exchange rates,
-// and broadcast pre-vote and vote transactions such that they're committed in a
-// block during each voting period.
 const (
+	// We define tickerTimeout as the minimum timeout between each oracle loop
+	// when new blocks cannot be waited for. We define this value empirically
+	// based on enough time to collect exchange rates, and broadcast pre-vote and
+	// vote transactions such that they're committed in a block during each
+	// voting period.
 	tickerTimeout = 1000 * time.Millisecond
+
+	// newBlockTimeout defines how long new blocks are waited for before the
+	// chain height is polled instead, e.g. while the NewBlock subscriptions are
+	// closed.
+	newBlockTimeout = 30 * time.Second
 )
 
 var (
 	// deviationThreshold defines how many 𝜎 a provider can be away from the mean
-	// without being considered faulty.
+	// without being considered faulty, unless configured otherwise for a base.
 	deviationThreshold = sdk.MustNewDecFromStr("2")
 )
 
 // PreviousPrevote defines a structure for defining the previous prevote
 // submitted on-chain.
 type PreviousPrevote struct {
-	ExchangeRates     string
-	Salt              string
-	SubmitBlockHeight int64
+	ExchangeRates     string `json:"exchange_rates"`
+	Salt              string `json:"salt"`
+	SubmitBlockHeight int64  `json:"submit_block_height"`
 }
 
 func NewPreviousPrevote() *PreviousPrevote {
@@ -56,6 +61,35 @@ func NewPreviousPrevote() *PreviousPrevote {
 	}
 }
 
+// ParamCache defines the x/oracle params cached by the oracle, along with the
+// block height at which they were queried.
+type ParamCache struct {
+	params           *oracletypes.Params
+	lastUpdatedBlock int64
+}
+
+// IsOutdated returns true if the params were never queried or were queried in
+// an earlier vote period than the given height, so that governance changes to
+// the params are picked up at the next vote period boundary.
+func (pc ParamCache) IsOutdated(currentBlockHeight int64) bool {
+	if pc.params == nil || currentBlockHeight < pc.lastUpdatedBlock {
+		return true
+	}
+
+	votePeriod := int64(pc.params.VotePeriod)
+	if votePeriod <= 0 {
+		return true
+	}
+
+	return currentBlockHeight/votePeriod != pc.lastUpdatedBlock/votePeriod
+}
+
+// Update caches the given params queried at the given height.
+func (pc *ParamCache) Update(currentBlockHeight int64, params oracletypes.Params) {
+	pc.params = &params
+	pc.lastUpdatedBlock = currentBlockHeight
+}
+
 // Oracle implements the core component responsible for fetching exchange rates
 // for a given set of currency pairs and determining the correct exchange rates
 // to submit to the on-chain price oracle adhering the oracle specification.
@@ -63,18 +97,100 @@ type Oracle struct {
 	logger zerolog.Logger
 	closer *pfsync.Closer
 
-	providerPairs      map[string][]types.CurrencyPair
 	previousPrevote    *PreviousPrevote
 	previousVotePeriod float64
-	priceProviders     map[string]provider.Provider
 	oracleClient       client.OracleClient
+	stateFile          string
+	tvwapWindow        time.Duration
+	maxCandleAge       time.Duration
+	prevoteRestored    bool
+	paramCache         ParamCache
+	dryRun             bool
+	dryRunState        dryRunState
+
+	providerMtx       sync.Mutex
+	providerPairs     map[string][]types.CurrencyPair
+	candleFallbacks   map[types.CurrencyPair]string
+	providerEndpoints map[string]provider.Endpoints
+	priceProviders    map[string]provider.Provider
+	providerCancels   map[string]context.CancelFunc
+	deviationFilters  map[string]deviationFilter
 
 	mtx             sync.RWMutex
 	lastPriceSyncTS time.Time
+	blockHeight     int64
 	prices          map[string]sdk.Dec
+	requiredRates   map[string]struct{}
+	pricesTimestamp time.Time
+	pricesHeight    int64
+	priceReport     PriceReport
+	voteStatus      VoteStatus
+	providerHealth  map[string]map[string]*ProviderHealth
 }
 
-func New(logger zerolog.Logger, oc client.OracleClient, currencyPairs []config.CurrencyPair) *Oracle {
+// Options defines the configuration of an Oracle.
+type Options struct {
+	CurrencyPairs []config.CurrencyPair
+
+	// Endpoints override the registered default endpoints of the providers.
+	Endpoints []config.ProviderEndpoint
+
+	// Deviations define how prices deviating from the other providers are
+	// filtered out. Bases without one are filtered 2𝜎 away from the mean.
+	Deviations []config.Deviation
+
+	// TVWAPWindow is the window of the candles over which the TVWAP is
+	// computed, and candles older than MaxCandleAge are discarded as stale.
+	TVWAPWindow  time.Duration
+	MaxCandleAge time.Duration
+
+	// StateFile, if set, persists the pending prevote so that it can still be
+	// revealed after a restart.
+	StateFile string
+
+	// DryRun computes pre-votes and votes without broadcasting them, and
+	// compares their prices with the on-chain exchange rates.
+	DryRun bool
+}
+
+// New returns a new Oracle configured with the given options.
+func New(logger zerolog.Logger, oc client.OracleClient, opts Options) *Oracle {
+	return &Oracle{
+		logger:            logger.With().Str("module", "oracle").Logger(),
+		closer:            pfsync.NewCloser(),
+		oracleClient:      oc,
+		stateFile:         opts.StateFile,
+		tvwapWindow:       opts.TVWAPWindow,
+		maxCandleAge:      opts.MaxCandleAge,
+		prevoteRestored:   len(opts.StateFile) == 0 || opts.DryRun,
+		dryRun:            opts.DryRun,
+		voteStatus:        VoteStatus{DryRun: opts.DryRun},
+		providerPairs:     newProviderPairs(opts.CurrencyPairs),
+		candleFallbacks:   newCandleFallbacks(opts.CurrencyPairs),
+		providerEndpoints: newProviderEndpoints(opts.Endpoints),
+		priceProviders:    make(map[string]provider.Provider),
+		providerCancels:   make(map[string]context.CancelFunc),
+		deviationFilters:  newDeviationFilters(opts.Deviations),
+		previousPrevote:   nil,
+	}
+}
+
+// newProviderEndpoints maps the configured endpoint overrides by provider.
+func newProviderEndpoints(endpoints []config.ProviderEndpoint) map[string]provider.Endpoints {
+	providerEndpoints := make(map[string]provider.Endpoints, len(endpoints))
+	for _, e := range endpoints {
+		providerEndpoints[e.Name] = provider.Endpoints{
+			Rest:      e.Rest,
+			Websocket: e.Websocket,
+		}
+	}
+
+	return providerEndpoints
+}
+
+// newProviderPairs groups the configured currency pairs by the providers they
+// are fetched from.
+func newProviderPairs(currencyPairs []config.CurrencyPair) map[string][]types.CurrencyPair {
 	providerPairs := make(map[string][]types.CurrencyPair)
 
 	for _, pair := range currencyPairs {
@@ -86,18 +202,32 @@ func New(logger zerolog.Logger, oc client.OracleClient, currencyPairs []config.C
 		}
 	}
 
-	return &Oracle{
-		logger:          logger.With().Str("module", "oracle").Logger(),
-		closer:          pfsync.NewCloser(),
-		oracleClient:    oc,
-		providerPairs:   providerPairs,
-		priceProviders:  make(map[string]provider.Provider),
-		previousPrevote: nil,
+	return providerPairs
+}
+
+// newCandleFallbacks returns the candle fallback of every configured currency
+// pair, which defaults to the VWAP of its tickers.
+func newCandleFallbacks(currencyPairs []config.CurrencyPair) map[types.CurrencyPair]string {
+	candleFallbacks := make(map[types.CurrencyPair]string, len(currencyPairs))
+
+	for _, pair := range currencyPairs {
+		fallback := pair.CandleFallback
+		if len(fallback) == 0 {
+			fallback = config.CandleFallbackVWAP
+		}
+
+		candleFallbacks[types.CurrencyPair{Base: pair.Base, Quote: pair.Quote}] = fallback
 	}
+
+	return candleFallbacks
 }
 
-// Start starts the oracle process in a blocking fashion.
+// Start starts the oracle process in a blocking fashion. The oracle ticks once
+// per new block, so that pre-votes and votes are broadcast as soon as a voting
+// period starts.
 func (o *Oracle) Start(ctx context.Context) error {
+	var blockHeight int64
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -113,12 +243,51 @@ func (o *Oracle) Start(ctx context.Context) error {
 				o.logger.Err(err).Msg("oracle tick failed")
 			}
 
-			o.lastPriceSyncTS = time.Now()
-
 			telemetry.MeasureSince(startTime, "runtime", "tick")
 			telemetry.IncrCounter(1, "new", "tick")
 
-			time.Sleep(tickerTimeout)
+			blockHeight = o.waitForNewBlock(ctx, blockHeight)
+		}
+	}
+}
+
+// waitForNewBlock blocks until a block higher than the given height is
+// received and returns its height. If no new block is received within
+// newBlockTimeout, the chain height is polled every tickerTimeout instead.
+func (o *Oracle) waitForNewBlock(ctx context.Context, height int64) int64 {
+	if o.oracleClient.ChainHeight == nil {
+		time.Sleep(tickerTimeout)
+		return height
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, newBlockTimeout)
+	defer cancel()
+
+	newHeight, err := o.oracleClient.ChainHeight.WaitForNewBlock(waitCtx, height)
+	if err == nil {
+		return newHeight
+	}
+	if ctx.Err() != nil {
+		return height
+	}
+
+	o.logger.Warn().Err(err).Msg("failed to wait for new block; polling the chain height")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return height
+
+		case <-time.After(tickerTimeout):
+		}
+
+		newHeight, err := o.oracleClient.GetChainHeight(ctx)
+		if err != nil {
+			o.logger.Err(err).Msg("failed to poll the chain height")
+			continue
+		}
+		if newHeight > height {
+			return newHeight
 		}
 	}
 }
@@ -129,8 +298,9 @@ func (o *Oracle) Stop() {
 	<-o.closer.Done()
 }
 
-// GetLastPriceSyncTimestamp returns the latest timestamp at which prices where
-// fetched from the oracle's set of exchange rate providers.
+// GetLastPriceSyncTimestamp returns the latest timestamp at which prices were
+// successfully fetched from the oracle's set of exchange rate providers. Failed
+// ticks do not update it.
 func (o *Oracle) GetLastPriceSyncTimestamp() time.Time {
 	o.mtx.RLock()
 	defer o.mtx.RUnlock()
@@ -154,71 +324,195 @@ func (o *Oracle) GetPrices() map[string]sdk.Dec {
 	return prices
 }
 
+// GetPriceSnapshot returns a copy of the current prices, along with the time
+// and the block height they were computed at.
+func (o *Oracle) GetPriceSnapshot() PriceSnapshot {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	prices := make(map[string]sdk.Dec, len(o.prices))
+	for k, v := range o.prices {
+		prices[k] = v
+	}
+
+	return PriceSnapshot{
+		Prices:      prices,
+		Timestamp:   o.pricesTimestamp,
+		BlockHeight: o.pricesHeight,
+	}
+}
+
+// UpdateCurrencyPairs replaces the set of currency pairs the oracle fetches
+// exchange rates for, e.g. after the configuration was reloaded. Running
+// providers are subscribed to the pairs they did not quote yet, and providers
+// no longer used by any pair are stopped. Providers that were not running yet
+// are started on the next tick. The pending prevote is kept, so that it can
+// still be revealed in the next voting period.
+func (o *Oracle) UpdateCurrencyPairs(currencyPairs []config.CurrencyPair) {
+	providerPairs := newProviderPairs(currencyPairs)
+
+	o.providerMtx.Lock()
+	defer o.providerMtx.Unlock()
+
+	for providerName, priceProvider := range o.priceProviders {
+		pairs, ok := providerPairs[providerName]
+		if !ok {
+			o.logger.Info().Str("provider", providerName).Msg("stopping unused provider")
+			o.stopProvider(providerName)
+			continue
+		}
+
+		subscribedPairs := make(map[string]struct{}, len(o.providerPairs[providerName]))
+		for _, pair := range o.providerPairs[providerName] {
+			subscribedPairs[pair.String()] = struct{}{}
+		}
+
+		var newPairs []types.CurrencyPair
+		for _, pair := range pairs {
+			if _, ok := subscribedPairs[pair.String()]; !ok {
+				newPairs = append(newPairs, pair)
+			}
+		}
+		if len(newPairs) == 0 {
+			continue
+		}
+
+		if err := priceProvider.SubscribeCurrencyPairs(newPairs...); err != nil {
+			// the provider is started again with all of its pairs on the next tick
+			o.logger.Err(err).Str("provider", providerName).Msg("failed to subscribe to new currency pairs")
+			o.stopProvider(providerName)
+		}
+	}
+
+	o.providerPairs = providerPairs
+	o.candleFallbacks = newCandleFallbacks(currencyPairs)
+}
+
+// UpdateDeviations replaces the deviation filters of the bases, e.g. after the
+// configuration was reloaded. They apply from the next price update on.
+func (o *Oracle) UpdateDeviations(deviations []config.Deviation) {
+	deviationFilters := newDeviationFilters(deviations)
+
+	o.providerMtx.Lock()
+	defer o.providerMtx.Unlock()
+
+	o.deviationFilters = deviationFilters
+}
+
+// UpdateEndpoints replaces the endpoint overrides of the providers, e.g. after
+// the configuration was reloaded. Running providers whose endpoints changed are
+// stopped, so that they are started with the new endpoints on the next tick.
+func (o *Oracle) UpdateEndpoints(endpoints []config.ProviderEndpoint) {
+	providerEndpoints := newProviderEndpoints(endpoints)
+
+	o.providerMtx.Lock()
+	defer o.providerMtx.Unlock()
+
+	for providerName := range o.priceProviders {
+		if providerEndpoints[providerName] != o.providerEndpoints[providerName] {
+			o.logger.Info().Str("provider", providerName).Msg("restarting provider with new endpoints")
+			o.stopProvider(providerName)
+		}
+	}
+
+	o.providerEndpoints = providerEndpoints
+}
+
 // SetPrices retrieves all the prices and candles from our set of providers as
-// determined in the config. If candles are available, uses TVWAP in order
-// to determine prices. If candles are not available, uses the most recent prices
-// with VWAP. Warns the the user of any missing prices, and filters out any faulty
-// providers which do not report prices or candles within 2𝜎 of the others.
+// determined in the config, converted to USD. If candles are available, uses
+// TVWAP in order to determine prices, otherwise uses the most recent prices with
+// VWAP. Stale candles and deviating providers are filtered out first. Bases
+// that cannot be priced are left out, and an error is only returned if none of
+// them can be priced.
 func (o *Oracle) SetPrices(ctx context.Context, acceptList oracletypes.DenomList) error {
 	g := new(errgroup.Group)
 	mtx := new(sync.Mutex)
-	providerPrices := make(provider.AggregatedProviderPrices)
-	providerCandles := make(provider.AggregatedProviderCandles)
+	pairPrices := make(pairProviderPrices)
+	pairCandles := make(pairProviderCandles)
+	providerErrs := make(map[string]error)
 	requiredRates := make(map[string]struct{})
 
-	for providerName, currencyPairs := range o.providerPairs {
-		providerName := providerName
-		currencyPairs := currencyPairs
+	priceProviders, providerPairs, err := o.getProviders(ctx)
+	if err != nil {
+		return err
+	}
 
-		priceProvider, err := o.getOrSetProvider(ctx, providerName)
-		if err != nil {
-			return err
+	o.providerMtx.Lock()
+	candleFallbacks := o.candleFallbacks
+	o.providerMtx.Unlock()
+
+	var allPairs []types.CurrencyPair
+	for _, currencyPairs := range providerPairs {
+		allPairs = append(allPairs, currencyPairs...)
+	}
+
+	var acceptedBases []string
+	for _, pair := range allPairs {
+		if acceptList.Contains(pair.Base) {
+			acceptedBases = append(acceptedBases, pair.Base)
+			requiredRates[pair.Base] = struct{}{}
 		}
+	}
+
+	// pairs of unaccepted denoms are still needed to convert the pairs quoted
+	// in them to USD
+	conversionBases := types.ConversionBases(allPairs, acceptedBases...)
+
+	var convertedPairs []types.CurrencyPair
+	fetchedPairs := make(map[string][]types.CurrencyPair, len(providerPairs))
+	for providerName, currencyPairs := range providerPairs {
+		providerName := providerName
+		priceProvider := priceProviders[providerName]
 
 		var acceptedPairs []types.CurrencyPair
 		for _, pair := range currencyPairs {
-			if acceptList.Contains(pair.Base) {
+			if _, ok := conversionBases[strings.ToUpper(pair.Base)]; ok {
 				acceptedPairs = append(acceptedPairs, pair)
-				if _, ok := requiredRates[pair.Base]; !ok {
-					requiredRates[pair.Base] = struct{}{}
-				}
+				convertedPairs = append(convertedPairs, pair)
 			} else {
 				o.logger.Warn().Str("denom", pair.Base).Msg("attempting to vote on unaccepted denom")
 			}
 		}
+		fetchedPairs[providerName] = acceptedPairs
 
 		g.Go(func() error {
 			prices, err := priceProvider.GetTickerPrices(acceptedPairs...)
 			if err != nil {
 				telemetry.IncrCounter(1, "failure", "provider", "type", "ticker")
+				mtx.Lock()
+				providerErrs[providerName] = err
+				mtx.Unlock()
 				return err
 			}
 
 			candles, err := priceProvider.GetCandlePrices(acceptedPairs...)
 			if err != nil {
 				telemetry.IncrCounter(1, "failure", "provider", "type", "candle")
+				mtx.Lock()
+				providerErrs[providerName] = err
+				mtx.Unlock()
 				return err
 			}
 
-			// flatten and collect prices based on the base currency per provider
+			// flatten and collect prices based on the currency pair per provider
 			//
-			// e.g.: {ProviderKraken: {"ATOM": <price, volume>, ...}}
+			// e.g.: {ProviderKraken: {ATOM/USDT: <price, volume>, ...}}
 			mtx.Lock()
 			for _, pair := range acceptedPairs {
-				if _, ok := providerPrices[providerName]; !ok {
-					providerPrices[providerName] = make(map[string]provider.TickerPrice)
+				if _, ok := pairPrices[providerName]; !ok {
+					pairPrices[providerName] = make(map[types.CurrencyPair]provider.TickerPrice)
 				}
-				if _, ok := providerCandles[providerName]; !ok {
-					providerCandles[providerName] = make(map[string][]provider.CandlePrice)
+				if _, ok := pairCandles[providerName]; !ok {
+					pairCandles[providerName] = make(map[types.CurrencyPair][]provider.CandlePrice)
 				}
 
 				tp, pricesOk := prices[pair.String()]
 				cp, candlesOk := candles[pair.String()]
 				if pricesOk {
-					providerPrices[providerName][pair.Base] = tp
+					pairPrices[providerName][pair] = tp
 				}
 				if candlesOk {
-					providerCandles[providerName][pair.Base] = cp
+					pairCandles[providerName][pair] = cp
 				}
 
 				if !pricesOk && !candlesOk {
@@ -235,101 +529,282 @@ func (o *Oracle) SetPrices(ctx context.Context, acceptList oracletypes.DenomList
 	if err := g.Wait(); err != nil {
 		o.logger.Debug().Err(err).Msg("failed to get ticker prices from provider")
 	}
+	for providerName, err := range providerErrs {
+		o.logger.Warn().Err(err).Str("provider", providerName).Msg("failed to get prices from provider")
+	}
+
+	o.discardStaleCandles(pairPrices, pairCandles, candleFallbacks)
+
+	// the pairs quarantined by the previous price sync are left out of the
+	// conversion rates and the deviation filters, so that they cannot skew the
+	// prices of the other providers
+	quarantined := o.quarantinedPairs()
+	activePrices, quarantinedPrices := splitQuarantinedPrices(pairPrices, quarantined)
+	activeCandles, quarantinedCandles := splitQuarantinedCandles(pairCandles, quarantined)
+
+	// convert all the pairs to USD, collecting prices based on the base
+	// currency per provider
+	//
+	// e.g.: {ProviderKraken: {"ATOM": <price, volume>, ...}}
+	providerPrices, providerCandles, rates, err := o.convertToUSD(convertedPairs, activePrices, activeCandles)
+	if err != nil {
+		return err
+	}
 
 	filteredCandles, err := o.filterCandleDeviations(providerCandles)
 	if err != nil {
 		return err
 	}
 
-	// attempt to use candles for TVWAP calculations
-	tvwapPrices, err := ComputeTVWAP(filteredCandles)
+	filteredProviderPrices, err := o.filterTickerDeviations(providerPrices)
 	if err != nil {
 		return err
 	}
 
-	// If TVWAP candles are not available or were filtered out due to staleness,
-	// use most recent prices & VWAP instead.
-	if len(tvwapPrices) == 0 {
-		filteredProviderPrices, err := o.filterTickerDeviations(providerPrices)
-		if err != nil {
-			return err
-		}
+	deviatingTickers := deviatingTickers(providerPrices, filteredProviderPrices)
+	deviatingCandles := deviatingCandles(providerCandles, filteredCandles)
+
+	// quarantined pairs are readmitted once they agree with the prices that
+	// passed the deviation filters
+	agreeingPairs, err := o.agreeingQuarantinedPairs(
+		rates,
+		quarantinedPrices,
+		quarantinedCandles,
+		filteredProviderPrices,
+		filteredCandles,
+	)
+	if err != nil {
+		return err
+	}
 
-		vwapPrices, err := ComputeVWAP(filteredProviderPrices)
-		if err != nil {
-			return err
-		}
+	o.updateProviderHealth(
+		time.Now(),
+		fetchedPairs,
+		providerErrs,
+		pairPrices,
+		pairCandles,
+		deviatingTickers,
+		deviatingCandles,
+		agreeingPairs,
+	)
 
-		// warn the user of any missing prices
-		reportedPrices := make(map[string]struct{})
-		for _, providers := range filteredProviderPrices {
-			for base := range providers {
-				if _, ok := reportedPrices[base]; !ok {
-					reportedPrices[base] = struct{}{}
+	// bases only used for conversion are not voted on
+	for _, aggregated := range []provider.AggregatedProviderPrices{providerPrices, filteredProviderPrices} {
+		for _, prices := range aggregated {
+			for base := range prices {
+				if _, ok := requiredRates[base]; !ok {
+					delete(prices, base)
 				}
 			}
 		}
-
-		if len(reportedPrices) != len(requiredRates) {
-			return fmt.Errorf("unable to get prices for all exchange prices")
-		}
-		for base := range requiredRates {
-			if _, ok := reportedPrices[base]; !ok {
-				return fmt.Errorf("reported prices were not equal to required rates")
+	}
+	for _, aggregated := range []provider.AggregatedProviderCandles{providerCandles, filteredCandles} {
+		for _, candles := range aggregated {
+			for base := range candles {
+				if _, ok := requiredRates[base]; !ok {
+					delete(candles, base)
+				}
 			}
 		}
+	}
 
-		o.prices = vwapPrices
-	} else {
-		// warn the user of any missing candles
-		reportedCandles := make(map[string]struct{})
-		for _, providers := range filteredCandles {
-			for base := range providers {
-				if _, ok := reportedCandles[base]; !ok {
-					reportedCandles[base] = struct{}{}
-				}
-			}
+	tvwapPrices, err := ComputeTVWAP(filteredCandles, o.tvwapWindow)
+	if err != nil {
+		return err
+	}
+
+	vwapPrices, err := ComputeVWAP(filteredProviderPrices)
+	if err != nil {
+		return err
+	}
+
+	candleCounts := make(map[string]int)
+	for _, providers := range filteredCandles {
+		for base := range providers {
+			candleCounts[base]++
 		}
+	}
+	tickerCounts := make(map[string]int)
+	for _, providers := range filteredProviderPrices {
+		for base := range providers {
+			tickerCounts[base]++
+		}
+	}
 
-		if len(reportedCandles) != len(requiredRates) {
-			return fmt.Errorf("unable to get prices for all exchange candles")
+	// Vote on every required rate that can be priced confidently, and abstain
+	// from the others instead of skipping the whole vote. Use the TVWAP of the
+	// candles, and the VWAP of the most recent prices if candles are not
+	// available or were filtered out due to staleness.
+	prices := make(map[string]sdk.Dec, len(requiredRates))
+	for base := range requiredRates {
+		if price, ok := tvwapPrices[base]; ok && o.hasAgreement(base, candleCounts[base]) {
+			prices[base] = price
+			continue
 		}
-		for base := range requiredRates {
-			if _, ok := reportedCandles[base]; !ok {
-				return fmt.Errorf("reported candles were not equal to required rates")
-			}
+		if price, ok := vwapPrices[base]; ok && o.hasAgreement(base, tickerCounts[base]) {
+			prices[base] = price
+			continue
 		}
 
-		o.prices = tvwapPrices
+		if candleCounts[base] > 0 || tickerCounts[base] > 0 {
+			o.logger.Warn().
+				Str("base", base).
+				Int("min_providers", o.deviationFilter(base).minProviders).
+				Msg("not enough providers agreeing on price, abstaining")
+		}
+	}
+
+	report := PriceReport{
+		Timestamp:        time.Now(),
+		Tickers:          providerPrices,
+		Candles:          providerCandles,
+		DeviatingTickers: deviatingTickers,
+		DeviatingCandles: deviatingCandles,
+		TVWAP:            tvwapPrices,
+		VWAP:             vwapPrices,
+		Prices:           prices,
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.priceReport = report
+	o.requiredRates = requiredRates
+
+	if len(requiredRates) > 0 && len(prices) == 0 {
+		return fmt.Errorf("unable to get prices for any required rates")
 	}
 
+	o.prices = prices
+	o.pricesTimestamp = report.Timestamp
+	o.lastPriceSyncTS = report.Timestamp
+	o.pricesHeight = o.blockHeight
 	return nil
 }
 
+// GetParamCache returns the cached x/oracle params, querying them again if they
+// are outdated at the given height.
+func (o *Oracle) GetParamCache(currentBlockHeight int64) (oracletypes.Params, error) {
+	if !o.paramCache.IsOutdated(currentBlockHeight) {
+		return *o.paramCache.params, nil
+	}
+
+	params, err := o.GetParams()
+	if err != nil {
+		return oracletypes.Params{}, err
+	}
+
+	o.paramCache.Update(currentBlockHeight, params)
+
+	return params, nil
+}
+
 // GetParams returns the current on-chain parameters of the x/oracle module.
 func (o *Oracle) GetParams() (oracletypes.Params, error) {
-	grpcConn, err := grpc.Dial(
-		o.oracleClient.GRPCEndpoint,
-		// the Cosmos SDK doesn't support any transport security mechanism
-		grpc.WithInsecure(),
-		grpc.WithContextDialer(dialerFunc),
-	)
+	var params oracletypes.Params
+
+	err := o.queryWithFailover(func(ctx context.Context, queryClient oracletypes.QueryClient) error {
+		queryResponse, err := queryClient.Params(ctx, &oracletypes.QueryParamsRequest{})
+		if err != nil {
+			return err
+		}
+
+		params = queryResponse.Params
+		return nil
+	})
 	if err != nil {
-		return oracletypes.Params{}, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+		return oracletypes.Params{}, fmt.Errorf("failed to get x/oracle params: %w", err)
 	}
 
-	defer grpcConn.Close()
-	queryClient := oracletypes.NewQueryClient(grpcConn)
+	return params, nil
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
+// GetAggregatePrevote returns the aggregate prevote of the given validator
+// currently stored on-chain.
+func (o *Oracle) GetAggregatePrevote(valAddr sdk.ValAddress) (oracletypes.AggregateExchangeRatePrevote, error) {
+	var aggregatePrevote oracletypes.AggregateExchangeRatePrevote
+
+	err := o.queryWithFailover(func(ctx context.Context, queryClient oracletypes.QueryClient) error {
+		queryResponse, err := queryClient.AggregatePrevote(ctx, &oracletypes.QueryAggregatePrevoteRequest{
+			ValidatorAddr: valAddr.String(),
+		})
+		if err != nil {
+			return err
+		}
 
-	queryResponse, err := queryClient.Params(ctx, &oracletypes.QueryParamsRequest{})
+		aggregatePrevote = queryResponse.AggregatePrevote
+		return nil
+	})
 	if err != nil {
-		return oracletypes.Params{}, fmt.Errorf("failed to get x/oracle params: %w", err)
+		return oracletypes.AggregateExchangeRatePrevote{}, fmt.Errorf("failed to get aggregate prevote: %w", err)
 	}
 
-	return queryResponse.Params, nil
+	return aggregatePrevote, nil
+}
+
+// GetExchangeRates returns the exchange rates currently stored on-chain, keyed
+// by upper cased symbol denom.
+func (o *Oracle) GetExchangeRates() (sdk.DecCoins, error) {
+	var exchangeRates sdk.DecCoins
+
+	err := o.queryWithFailover(func(ctx context.Context, queryClient oracletypes.QueryClient) error {
+		queryResponse, err := queryClient.ExchangeRates(ctx, &oracletypes.QueryExchangeRatesRequest{})
+		if err != nil {
+			return err
+		}
+
+		exchangeRates = queryResponse.ExchangeRates
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+
+	return exchangeRates, nil
+}
+
+// GetActiveNode returns the endpoints of the Umee node currently in use.
+func (o *Oracle) GetActiveNode() client.NodeEndpoints {
+	if o.oracleClient.Nodes == nil {
+		return client.NodeEndpoints{}
+	}
+
+	return o.oracleClient.Nodes.Active().NodeEndpoints
+}
+
+// getProviders returns the price providers along with the currency pairs to
+// fetch from each of them, starting any provider that is not running yet.
+func (o *Oracle) getProviders(
+	ctx context.Context,
+) (map[string]provider.Provider, map[string][]types.CurrencyPair, error) {
+	o.providerMtx.Lock()
+	defer o.providerMtx.Unlock()
+
+	priceProviders := make(map[string]provider.Provider, len(o.providerPairs))
+	providerPairs := make(map[string][]types.CurrencyPair, len(o.providerPairs))
+
+	for providerName, currencyPairs := range o.providerPairs {
+		priceProvider, err := o.getOrSetProvider(ctx, providerName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		priceProviders[providerName] = priceProvider
+		providerPairs[providerName] = currencyPairs
+	}
+
+	return priceProviders, providerPairs, nil
+}
+
+// stopProvider stops the given provider and forgets about it. The caller must
+// hold the provider lock.
+func (o *Oracle) stopProvider(providerName string) {
+	if cancel, ok := o.providerCancels[providerName]; ok {
+		cancel()
+	}
+
+	delete(o.providerCancels, providerName)
+	delete(o.priceProviders, providerName)
 }
 
 func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (provider.Provider, error) {
@@ -340,57 +815,32 @@ func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (pro
 
 	priceProvider, ok = o.priceProviders[providerName]
 	if !ok {
-		switch providerName {
-		case config.ProviderBinance:
-			binanceProvider, err := provider.NewBinanceProvider(ctx, o.logger, o.providerPairs[config.ProviderBinance]...)
-			if err != nil {
-				return nil, err
-			}
-			priceProvider = binanceProvider
-
-		case config.ProviderKraken:
-			krakenProvider, err := provider.NewKrakenProvider(ctx, o.logger, o.providerPairs[config.ProviderKraken]...)
-			if err != nil {
-				return nil, err
-			}
-			priceProvider = krakenProvider
-
-		case config.ProviderOsmosis:
-			priceProvider = provider.NewOsmosisProvider()
-
-		case config.ProviderHuobi:
-			huobiProvider, err := provider.NewHuobiProvider(ctx, o.logger, o.providerPairs[config.ProviderHuobi]...)
-			if err != nil {
-				return nil, err
-			}
-			priceProvider = huobiProvider
-
-		case config.ProviderOkx:
-			okxProvider, err := provider.NewOkxProvider(ctx, o.logger, o.providerPairs[config.ProviderOkx]...)
-			if err != nil {
-				return nil, err
-			}
-			priceProvider = okxProvider
-
-		case config.ProviderGate:
-			gateProvider, err := provider.NewGateProvider(ctx, o.logger, o.providerPairs[config.ProviderGate]...)
-			if err != nil {
-				return nil, err
-			}
-			priceProvider = gateProvider
-
-		case config.ProviderMock:
-			priceProvider = provider.NewMockProvider()
+		// every provider runs on its own context, so it can be stopped on its own
+		// once it is no longer used
+		ctx, cancel := context.WithCancel(ctx)
+		newProvider, err := provider.New(
+			ctx,
+			o.logger,
+			providerName,
+			o.providerEndpoints[providerName],
+			o.providerPairs[providerName]...,
+		)
+		if err != nil {
+			cancel()
+			return nil, err
 		}
 
-		o.priceProviders[providerName] = priceProvider
+		o.priceProviders[providerName] = newProvider
+		o.providerCancels[providerName] = cancel
+		priceProvider = newProvider
 	}
 
 	return priceProvider, nil
 }
 
-// filterTickerDeviations finds the standard deviations of the prices of
-// all assets, and filters out any providers that are not within 2𝜎 of the mean.
+// filterTickerDeviations finds the deviations of the prices of all assets, and
+// filters out any providers that are not within the deviation thresholds of
+// their assets.
 func (o *Oracle) filterTickerDeviations(
 	prices provider.AggregatedProviderPrices,
 ) (provider.AggregatedProviderPrices, error) {
@@ -408,17 +858,16 @@ func (o *Oracle) filterTickerDeviations(
 		}
 	}
 
-	deviations, means, err := StandardDeviation(priceMap)
+	bounds, err := o.deviationBounds(priceMap)
 	if err != nil {
 		return nil, err
 	}
 
-	// accept any prices that are within 2𝜎, or for which we couldn't get 𝜎
+	// accept any prices that are within bounds, or for which we couldn't get
+	// bounds
 	for providerName, priceTickers := range prices {
 		for base, tp := range priceTickers {
-			if _, ok := deviations[base]; !ok ||
-				(tp.Price.GTE(means[base].Sub(deviations[base].Mul(deviationThreshold))) &&
-					tp.Price.LTE(means[base].Add(deviations[base].Mul(deviationThreshold)))) {
+			if b, ok := bounds[base]; !ok || b.contains(tp.Price) {
 				if _, ok := filteredPrices[providerName]; !ok {
 					filteredPrices[providerName] = make(map[string]provider.TickerPrice)
 				}
@@ -438,8 +887,9 @@ func (o *Oracle) filterTickerDeviations(
 	return filteredPrices, nil
 }
 
-// filterCandleDeviations finds the standard deviations of the tvwaps of
-// all assets, and filters out any providers that are not within 2𝜎 of the mean.
+// filterCandleDeviations finds the deviations of the tvwaps of all assets, and
+// filters out any providers that are not within the deviation thresholds of
+// their assets.
 func (o *Oracle) filterCandleDeviations(
 	candles provider.AggregatedProviderCandles,
 ) (provider.AggregatedProviderCandles, error) {
@@ -459,7 +909,7 @@ func (o *Oracle) filterCandleDeviations(
 			candlePrices[providerName][base] = cp
 		}
 
-		tvwap, err := ComputeTVWAP(candlePrices)
+		tvwap, err := ComputeTVWAP(candlePrices, o.tvwapWindow)
 		if err != nil {
 			return nil, err
 		}
@@ -473,17 +923,16 @@ func (o *Oracle) filterCandleDeviations(
 		}
 	}
 
-	deviations, means, err := StandardDeviation(tvwaps)
+	bounds, err := o.deviationBounds(tvwaps)
 	if err != nil {
 		return nil, err
 	}
 
-	// accept any tvwaps that are within 2𝜎, or for which we couldn't get 𝜎
+	// accept any tvwaps that are within bounds, or for which we couldn't get
+	// bounds
 	for providerName, priceMap := range tvwaps {
 		for base, price := range priceMap {
-			if _, ok := deviations[base]; !ok ||
-				(price.GTE(means[base].Sub(deviations[base].Mul(deviationThreshold))) &&
-					price.LTE(means[base].Add(deviations[base].Mul(deviationThreshold)))) {
+			if b, ok := bounds[base]; !ok || b.contains(price) {
 				if _, ok := filteredCandles[providerName]; !ok {
 					filteredCandles[providerName] = make(map[string][]provider.CandlePrice)
 				}
@@ -503,23 +952,62 @@ func (o *Oracle) filterCandleDeviations(
 	return filteredCandles, nil
 }
 
-func (o *Oracle) checkAcceptList(params oracletypes.Params) {
+// checkAcceptList warns the user of the denoms of the accept list that are
+// missing from the prices, which are omitted from the vote, and reports them
+// through telemetry. Denoms without any configured currency pair are not
+// priced on purpose, so they are not reported. The missing denoms are returned.
+func (o *Oracle) checkAcceptList(params oracletypes.Params) []string {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	required := make(map[string]struct{}, len(o.requiredRates))
+	for base := range o.requiredRates {
+		required[strings.ToUpper(base)] = struct{}{}
+	}
+	priced := make(map[string]struct{}, len(o.prices))
+	for base := range o.prices {
+		priced[strings.ToUpper(base)] = struct{}{}
+	}
+
+	var missing []string
 	for _, denom := range params.AcceptList {
 		symbol := strings.ToUpper(denom.SymbolDenom)
-		if _, ok := o.prices[symbol]; !ok {
+		if _, ok := required[symbol]; !ok {
+			continue
+		}
+		if _, ok := priced[symbol]; !ok {
+			missing = append(missing, symbol)
+			telemetry.IncrCounterWithLabels(
+				[]string{"failure", "price", "missing"},
+				1,
+				[]metrics.Label{telemetry.NewLabel("denom", symbol)},
+			)
 			o.logger.Warn().Str("denom", symbol).Msg("price missing for required denom")
 		}
 	}
+
+	telemetry.SetGauge(float32(len(missing)), "price", "missing")
+
+	return missing
 }
 
 func (o *Oracle) tick(ctx context.Context) error {
 	o.logger.Debug().Msg("executing oracle tick")
 
-	clientCtx, err := o.oracleClient.CreateClientContext()
+	blockHeight, err := o.oracleClient.ChainHeight.GetChainHeight()
 	if err != nil {
 		return err
 	}
-	oracleParams, err := o.GetParams()
+	if blockHeight < 1 {
+		return fmt.Errorf("expected positive block height")
+	}
+
+	// the prices set during the tick are computed at this height
+	o.mtx.Lock()
+	o.blockHeight = blockHeight
+	o.mtx.Unlock()
+
+	oracleParams, err := o.GetParamCache(blockHeight)
 	if err != nil {
 		return err
 	}
@@ -529,12 +1017,8 @@ func (o *Oracle) tick(ctx context.Context) error {
 
 	o.checkAcceptList(oracleParams)
 
-	blockHeight, err := rpcclient.GetChainHeight(clientCtx)
-	if err != nil {
-		return err
-	}
-	if blockHeight < 1 {
-		return fmt.Errorf("expected positive block height")
+	if o.dryRun {
+		return o.dryRunTick(blockHeight, oracleParams)
 	}
 
 	// Get oracle vote period, next block height, current vote period, and index
@@ -544,6 +1028,12 @@ func (o *Oracle) tick(ctx context.Context) error {
 	currentVotePeriod := math.Floor(float64(nextBlockHeight) / float64(oracleVotePeriod))
 	indexInVotePeriod := nextBlockHeight % oracleVotePeriod
 
+	if !o.prevoteRestored {
+		if err := o.restorePreviousPrevote(oracleVotePeriod, currentVotePeriod); err != nil {
+			return err
+		}
+	}
+
 	// Skip until new voting period. Specifically, skip when:
 	// index [0, oracleVotePeriod - 1] > oracleVotePeriod - 2 OR index is 0
 	if (o.previousVotePeriod != 0 && currentVotePeriod == o.previousVotePeriod) ||
@@ -567,7 +1057,7 @@ func (o *Oracle) tick(ctx context.Context) error {
 			Msg("missing vote during voting period")
 
 		o.previousVotePeriod = 0
-		o.previousPrevote = nil
+		o.setPreviousPrevote(nil)
 		return nil
 	}
 
@@ -600,21 +1090,23 @@ func (o *Oracle) tick(ctx context.Context) error {
 			Str("validator", preVoteMsg.Validator).
 			Str("feeder", preVoteMsg.Feeder).
 			Msg("broadcasting pre-vote")
-		if err := o.oracleClient.BroadcastTx(nextBlockHeight, oracleVotePeriod*2, preVoteMsg); err != nil {
+		resp, err := o.oracleClient.BroadcastTx(ctx, nextBlockHeight, oracleVotePeriod*2, preVoteMsg)
+		o.setPrevoteResult(newTxResult(hash.String(), exchangeRatesStr, nextBlockHeight, resp, err))
+		if err != nil {
 			return err
 		}
 
-		currentHeight, err := rpcclient.GetChainHeight(clientCtx)
+		currentHeight, err := o.oracleClient.ChainHeight.GetChainHeight()
 		if err != nil {
 			return err
 		}
 
 		o.previousVotePeriod = math.Floor(float64(currentHeight) / float64(oracleVotePeriod))
-		o.previousPrevote = &PreviousPrevote{
+		o.setPreviousPrevote(&PreviousPrevote{
 			Salt:              salt,
 			ExchangeRates:     exchangeRatesStr,
 			SubmitBlockHeight: currentHeight,
-		}
+		})
 	} else {
 		// otherwise, we're in the next voting period and thus we vote
 		voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
@@ -629,21 +1121,116 @@ func (o *Oracle) tick(ctx context.Context) error {
 			Str("validator", voteMsg.Validator).
 			Str("feeder", voteMsg.Feeder).
 			Msg("broadcasting vote")
-		if err := o.oracleClient.BroadcastTx(
+		resp, err := o.oracleClient.BroadcastTx(
+			ctx,
 			nextBlockHeight,
 			oracleVotePeriod-indexInVotePeriod,
 			voteMsg,
-		); err != nil {
+		)
+		o.setVoteResult(newTxResult(
+			oracletypes.GetAggregateVoteHash(voteMsg.Salt, voteMsg.ExchangeRates, valAddr).String(),
+			voteMsg.ExchangeRates,
+			nextBlockHeight,
+			resp,
+			err,
+		))
+		if err != nil {
 			return err
 		}
 
-		o.previousPrevote = nil
+		o.setPreviousPrevote(nil)
 		o.previousVotePeriod = 0
 	}
 
 	return nil
 }
 
+// setPreviousPrevote sets the pending prevote and persists it to the state
+// file, if any. Failing to persist the prevote only prevents it from being
+// revealed after a restart, so the error is logged rather than returned.
+func (o *Oracle) setPreviousPrevote(prevote *PreviousPrevote) {
+	o.previousPrevote = prevote
+
+	if len(o.stateFile) == 0 {
+		return
+	}
+	if err := SavePreviousPrevote(o.stateFile, prevote); err != nil {
+		o.logger.Err(err).Str("state_file", o.stateFile).Msg("failed to persist prevote")
+	}
+}
+
+// restorePreviousPrevote restores the prevote persisted by a previous run of
+// the price-feeder, so that it is revealed in the current voting period. The
+// prevote is discarded if its reveal period has passed or if it does not match
+// the validator's prevote on-chain, e.g. because there is none. An error is
+// returned if no node could be reached to query the on-chain prevote, in which
+// case the restore is retried on the next tick.
+func (o *Oracle) restorePreviousPrevote(votePeriod int64, currentVotePeriod float64) error {
+	prevote, err := LoadPreviousPrevote(o.stateFile)
+	if err != nil {
+		o.logger.Err(err).Str("state_file", o.stateFile).Msg("discarding persisted prevote")
+		o.prevoteRestored = true
+		return nil
+	}
+	if prevote == nil {
+		o.prevoteRestored = true
+		return nil
+	}
+
+	prevoteVotePeriod := math.Floor(float64(prevote.SubmitBlockHeight) / float64(votePeriod))
+	if currentVotePeriod-prevoteVotePeriod > 1 {
+		o.logger.Info().
+			Int64("submit_block_height", prevote.SubmitBlockHeight).
+			Msg("discarding persisted prevote; reveal period has passed")
+		o.prevoteRestored = true
+		o.setPreviousPrevote(nil)
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(o.oracleClient.ValidatorAddrString)
+	if err != nil {
+		return err
+	}
+
+	aggregatePrevote, err := o.GetAggregatePrevote(valAddr)
+	switch {
+	case err == nil:
+
+	case isUnavailable(err):
+		return err
+
+	default:
+		if !isNotFound(err, oracletypes.ErrNoAggregatePrevote) {
+			o.logger.Err(err).Msg("failed to query on-chain prevote")
+		}
+		o.logger.Info().Msg("discarding persisted prevote; no matching on-chain prevote")
+		o.prevoteRestored = true
+		o.setPreviousPrevote(nil)
+		return nil
+	}
+
+	o.prevoteRestored = true
+
+	hash := oracletypes.GetAggregateVoteHash(prevote.Salt, prevote.ExchangeRates, valAddr)
+	if aggregatePrevote.Hash != hash.String() {
+		o.logger.Info().
+			Str("hash", hash.String()).
+			Str("on_chain_hash", aggregatePrevote.Hash).
+			Msg("discarding persisted prevote; it does not match the on-chain prevote")
+		o.setPreviousPrevote(nil)
+		return nil
+	}
+
+	o.logger.Info().
+		Str("hash", hash.String()).
+		Int64("submit_block_height", prevote.SubmitBlockHeight).
+		Msg("restored persisted prevote")
+	o.previousPrevote = prevote
+	o.previousVotePeriod = prevoteVotePeriod
+
+	return nil
+}
+
 // GenerateSalt generates a random salt, size length/2,  as a HEX encoded string.
 func GenerateSalt(length int) (string, error) {
 	if length == 0 {
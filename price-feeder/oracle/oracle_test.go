@@ -19,6 +19,11 @@ import (
 	oracletypes "github.com/umee-network/umee/x/oracle/types"
 )
 
+const (
+	testTVWAPWindow  = 3 * time.Minute
+	testMaxCandleAge = 3 * time.Minute
+)
+
 type mockProvider struct {
 	prices map[string]provider.TickerPrice
 }
@@ -61,6 +66,16 @@ func (m failingProvider) SubscribeCurrencyPairs(_ ...types.CurrencyPair) error {
 	return nil
 }
 
+type subscribingProvider struct {
+	mockProvider
+	subscribedPairs []types.CurrencyPair
+}
+
+func (m *subscribingProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	m.subscribedPairs = append(m.subscribedPairs, cps...)
+	return nil
+}
+
 type OracleTestSuite struct {
 	suite.Suite
 
@@ -72,22 +87,26 @@ func (ots *OracleTestSuite) SetupSuite() {
 	ots.oracle = New(
 		zerolog.Nop(),
 		client.OracleClient{},
-		[]config.CurrencyPair{
-			{
-				Base:      "UMEE",
-				Quote:     "USDT",
-				Providers: []string{config.ProviderBinance},
-			},
-			{
-				Base:      "UMEE",
-				Quote:     "USDC",
-				Providers: []string{config.ProviderKraken},
-			},
-			{
-				Base:      "XBT",
-				Quote:     "USDT",
-				Providers: []string{config.ProviderOsmosis},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "UMEE",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderBinance},
+				},
+				{
+					Base:      "UMEE",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderKraken},
+				},
+				{
+					Base:      "XBT",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderOsmosis},
+				},
 			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
 		},
 	)
 }
@@ -119,7 +138,7 @@ func (ots *OracleTestSuite) TestPrices() {
 	// Use a mock provider with exchange rates that are not specified in
 	// configuration.
 	ots.oracle.priceProviders = map[string]provider.Provider{
-		config.ProviderBinance: mockProvider{
+		provider.ProviderBinance: mockProvider{
 			prices: map[string]provider.TickerPrice{
 				"UMEEUSDX": {
 					Price:  sdk.MustNewDecFromStr("3.72"),
@@ -127,7 +146,7 @@ func (ots *OracleTestSuite) TestPrices() {
 				},
 			},
 		},
-		config.ProviderKraken: mockProvider{
+		provider.ProviderKraken: mockProvider{
 			prices: map[string]provider.TickerPrice{
 				"UMEEUSDX": {
 					Price:  sdk.MustNewDecFromStr("3.70"),
@@ -149,17 +168,17 @@ func (ots *OracleTestSuite) TestPrices() {
 
 	// use a mock provider to provide prices for the configured exchange pairs
 	ots.oracle.priceProviders = map[string]provider.Provider{
-		config.ProviderBinance: mockProvider{
+		provider.ProviderBinance: mockProvider{
 			prices: map[string]provider.TickerPrice{
-				"UMEEUSDT": {
+				"UMEEUSD": {
 					Price:  sdk.MustNewDecFromStr("3.72"),
 					Volume: sdk.MustNewDecFromStr("2396974.02000000"),
 				},
 			},
 		},
-		config.ProviderKraken: mockProvider{
+		provider.ProviderKraken: mockProvider{
 			prices: map[string]provider.TickerPrice{
-				"UMEEUSDC": {
+				"UMEEUSD": {
 					Price:  sdk.MustNewDecFromStr("3.70"),
 					Volume: sdk.MustNewDecFromStr("1994674.34000000"),
 				},
@@ -175,7 +194,7 @@ func (ots *OracleTestSuite) TestPrices() {
 
 	// use one working provider and one provider with an incorrect exchange rate
 	ots.oracle.priceProviders = map[string]provider.Provider{
-		config.ProviderBinance: mockProvider{
+		provider.ProviderBinance: mockProvider{
 			prices: map[string]provider.TickerPrice{
 				"UMEEUSDX": {
 					Price:  sdk.MustNewDecFromStr("3.72"),
@@ -183,9 +202,9 @@ func (ots *OracleTestSuite) TestPrices() {
 				},
 			},
 		},
-		config.ProviderKraken: mockProvider{
+		provider.ProviderKraken: mockProvider{
 			prices: map[string]provider.TickerPrice{
-				"UMEEUSDC": {
+				"UMEEUSD": {
 					Price:  sdk.MustNewDecFromStr("3.70"),
 					Volume: sdk.MustNewDecFromStr("1994674.34000000"),
 				},
@@ -200,17 +219,17 @@ func (ots *OracleTestSuite) TestPrices() {
 
 	// use one working provider and one provider that fails
 	ots.oracle.priceProviders = map[string]provider.Provider{
-		config.ProviderBinance: failingProvider{
+		provider.ProviderBinance: failingProvider{
 			prices: map[string]provider.TickerPrice{
-				"UMEEUSDC": {
+				"UMEEUSD": {
 					Price:  sdk.MustNewDecFromStr("3.72"),
 					Volume: sdk.MustNewDecFromStr("2396974.02000000"),
 				},
 			},
 		},
-		config.ProviderKraken: mockProvider{
+		provider.ProviderKraken: mockProvider{
 			prices: map[string]provider.TickerPrice{
-				"UMEEUSDC": {
+				"UMEEUSD": {
 					Price:  sdk.MustNewDecFromStr("3.71"),
 					Volume: sdk.MustNewDecFromStr("1994674.34000000"),
 				},
@@ -225,17 +244,17 @@ func (ots *OracleTestSuite) TestPrices() {
 
 	// use one working provider and one for a coin not in accept list
 	ots.oracle.priceProviders = map[string]provider.Provider{
-		config.ProviderKraken: mockProvider{
+		provider.ProviderKraken: mockProvider{
 			prices: map[string]provider.TickerPrice{
-				"UMEEUSDC": {
+				"UMEEUSD": {
 					Price:  sdk.MustNewDecFromStr("3.71"),
 					Volume: sdk.MustNewDecFromStr("1994674.34000000"),
 				},
 			},
 		},
-		config.ProviderOsmosis: mockProvider{
+		provider.ProviderOsmosis: mockProvider{
 			prices: map[string]provider.TickerPrice{
-				"XBTUSDT": {
+				"XBTUSD": {
 					Price:  sdk.MustNewDecFromStr("3.71"),
 					Volume: sdk.MustNewDecFromStr("1994674.34000000"),
 				},
@@ -251,6 +270,301 @@ func (ots *OracleTestSuite) TestPrices() {
 	ots.Require().False(reportedUnacceptedDenom)
 }
 
+func TestSetPrices_Conversion(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "STATOM",
+					Quote:     "ATOM",
+					Providers: []string{provider.ProviderOsmosis},
+				},
+				{
+					Base:      "ATOM",
+					Quote:     "USDT",
+					Providers: []string{provider.ProviderBinance, provider.ProviderKraken},
+				},
+				{
+					Base:      "ATOM",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderCoinbase},
+				},
+				{
+					Base:      "USDT",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderKraken},
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderOsmosis: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"STATOMATOM": {
+					Price:  sdk.MustNewDecFromStr("1.1"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+		provider.ProviderBinance: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSDT": {
+					Price:  sdk.MustNewDecFromStr("10"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+		provider.ProviderKraken: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSDT": {
+					Price:  sdk.MustNewDecFromStr("10"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+				"USDTUSD": {
+					Price:  sdk.MustNewDecFromStr("0.99"),
+					Volume: sdk.MustNewDecFromStr("1000"),
+				},
+			},
+		},
+		provider.ProviderCoinbase: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr("9.9"),
+					Volume: sdk.MustNewDecFromStr("200"),
+				},
+			},
+		},
+	}
+
+	acceptList := oracletypes.DenomList{
+		oracletypes.Denom{BaseDenom: "UATOM", SymbolDenom: "ATOM", Exponent: 6},
+		oracletypes.Denom{BaseDenom: "USTATOM", SymbolDenom: "STATOM", Exponent: 6},
+	}
+
+	// USDT is only used to convert ATOM/USDT and is not reported
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	prices := oracle.GetPrices()
+	require.Len(t, prices, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("9.9"), prices["ATOM"])
+	require.Equal(t, sdk.MustNewDecFromStr("10.89"), prices["STATOM"])
+
+	// pairs quoted in a currency without a USD rate cannot be converted
+	oracle.priceProviders[provider.ProviderKraken] = mockProvider{
+		prices: map[string]provider.TickerPrice{
+			"ATOMUSDT": {
+				Price:  sdk.MustNewDecFromStr("10"),
+				Volume: sdk.MustNewDecFromStr("100"),
+			},
+		},
+	}
+	delete(oracle.priceProviders, provider.ProviderCoinbase)
+	delete(oracle.providerPairs, provider.ProviderCoinbase)
+
+	require.EqualError(
+		t,
+		oracle.SetPrices(context.TODO(), acceptList),
+		"unable to get prices for any required rates",
+	)
+}
+
+func TestSetPrices_Partial(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "ATOM",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderKraken},
+				},
+				{
+					Base:      "UMEE",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderBinance},
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderKraken: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr("10"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+		provider.ProviderBinance: failingProvider{},
+	}
+
+	acceptList := oracletypes.DenomList{
+		oracletypes.Denom{BaseDenom: "uatom", SymbolDenom: "atom", Exponent: 6},
+		oracletypes.Denom{BaseDenom: "uumee", SymbolDenom: "umee", Exponent: 6},
+		oracletypes.Denom{BaseDenom: "ujuno", SymbolDenom: "juno", Exponent: 6},
+	}
+
+	// the UMEE price is unavailable and omitted from the vote
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	prices := oracle.GetPrices()
+	require.Len(t, prices, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), prices["ATOM"])
+
+	// JUNO is not configured, so only UMEE is reported missing
+	require.Equal(t, []string{"UMEE"}, oracle.checkAcceptList(oracletypes.Params{AcceptList: acceptList}))
+
+	snapshot := oracle.GetPriceSnapshot()
+	require.Equal(t, prices, snapshot.Prices)
+	require.Equal(t, oracle.GetPriceReport().Timestamp, snapshot.Timestamp)
+	require.Equal(t, snapshot.Timestamp, oracle.GetLastPriceSyncTimestamp())
+
+	// no prices are available at all
+	oracle.priceProviders[provider.ProviderKraken] = failingProvider{}
+
+	require.EqualError(
+		t,
+		oracle.SetPrices(context.TODO(), acceptList),
+		"unable to get prices for any required rates",
+	)
+
+	// the failed sync leaves the last sync timestamp as is
+	require.Equal(t, snapshot.Timestamp, oracle.GetLastPriceSyncTimestamp())
+}
+
+func TestSetPrices_Deviation(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "ATOM",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderBinance, provider.ProviderKraken, provider.ProviderCoinbase},
+				},
+				{
+					Base:      "UMEE",
+					Quote:     "USD",
+					Providers: []string{provider.ProviderBinance, provider.ProviderKraken},
+				},
+			},
+			Deviations: []config.Deviation{
+				{
+					Base:         "ATOM",
+					Strategy:     config.DeviationStrategyPercent,
+					Threshold:    "5",
+					MinProviders: 2,
+				},
+				{
+					Base:         "UMEE",
+					Strategy:     config.DeviationStrategyMAD,
+					Threshold:    "1",
+					MinProviders: 2,
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderBinance: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr("10"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+				"UMEEUSD": {
+					Price:  sdk.MustNewDecFromStr("1"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+		provider.ProviderKraken: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr("10"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+				"UMEEUSD": {
+					Price:  sdk.MustNewDecFromStr("1.2"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+		provider.ProviderCoinbase: mockProvider{
+			prices: map[string]provider.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr("11"),
+					Volume: sdk.MustNewDecFromStr("100"),
+				},
+			},
+		},
+	}
+
+	acceptList := oracletypes.DenomList{
+		oracletypes.Denom{BaseDenom: "UATOM", SymbolDenom: "ATOM", Exponent: 6},
+		oracletypes.Denom{BaseDenom: "UUMEE", SymbolDenom: "UMEE", Exponent: 6},
+	}
+
+	// the coinbase ATOM price is more than 5% away from the median
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	prices := oracle.GetPrices()
+	require.Len(t, prices, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), prices["ATOM"])
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), prices["UMEE"])
+
+	report := oracle.GetPriceReport()
+	require.Equal(t, map[string][]string{provider.ProviderCoinbase: {"ATOM"}}, report.DeviatingTickers)
+	require.Equal(t, sdk.MustNewDecFromStr("11"), report.Tickers[provider.ProviderCoinbase]["ATOM"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), report.VWAP["ATOM"])
+	require.Equal(t, prices, report.Prices)
+
+	// only a single provider agrees on the ATOM price
+	oracle.priceProviders[provider.ProviderKraken] = mockProvider{
+		prices: map[string]provider.TickerPrice{
+			"ATOMUSD": {
+				Price:  sdk.MustNewDecFromStr("12"),
+				Volume: sdk.MustNewDecFromStr("100"),
+			},
+			"UMEEUSD": {
+				Price:  sdk.MustNewDecFromStr("1.2"),
+				Volume: sdk.MustNewDecFromStr("100"),
+			},
+		},
+	}
+
+	require.NoError(t, oracle.SetPrices(context.TODO(), acceptList))
+	prices = oracle.GetPrices()
+	require.Len(t, prices, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), prices["UMEE"])
+}
+
+func TestParamCache(t *testing.T) {
+	var paramCache ParamCache
+	require.True(t, paramCache.IsOutdated(100))
+
+	params := oracletypes.DefaultParams()
+	params.VotePeriod = 10
+	paramCache.Update(101, params)
+	require.False(t, paramCache.IsOutdated(101))
+	require.False(t, paramCache.IsOutdated(109))
+
+	// the params are queried again in every vote period
+	require.True(t, paramCache.IsOutdated(110))
+
+	// the chain restarted from a lower height
+	require.True(t, paramCache.IsOutdated(100))
+}
+
 func TestGenerateSalt(t *testing.T) {
 	salt, err := GenerateSalt(0)
 	require.Error(t, err)
@@ -295,3 +609,126 @@ func TestGenerateExchangeRatesString(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateCurrencyPairs(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "ATOM",
+					Quote:     "USDT",
+					Providers: []string{provider.ProviderBinance, provider.ProviderKraken},
+				},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	binanceProvider := &subscribingProvider{}
+	krakenProvider := &subscribingProvider{}
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderBinance: binanceProvider,
+		provider.ProviderKraken:  krakenProvider,
+	}
+
+	krakenStopped := false
+	oracle.providerCancels[provider.ProviderKraken] = func() { krakenStopped = true }
+
+	previousPrevote := &PreviousPrevote{
+		ExchangeRates:     "ATOM:11.1",
+		Salt:              "salt",
+		SubmitBlockHeight: 10,
+	}
+	oracle.previousPrevote = previousPrevote
+
+	oracle.UpdateCurrencyPairs([]config.CurrencyPair{
+		{
+			Base:      "ATOM",
+			Quote:     "USDT",
+			Providers: []string{provider.ProviderBinance},
+		},
+		{
+			Base:      "UMEE",
+			Quote:     "USDT",
+			Providers: []string{provider.ProviderBinance, provider.ProviderHuobi},
+		},
+	})
+
+	// running providers only subscribe to new pairs
+	umeePair := types.CurrencyPair{Base: "UMEE", Quote: "USDT"}
+	require.Equal(t, []types.CurrencyPair{umeePair}, binanceProvider.subscribedPairs)
+
+	// unused providers are stopped, new ones are started on the next tick
+	require.True(t, krakenStopped)
+	require.NotContains(t, oracle.priceProviders, provider.ProviderKraken)
+	require.NotContains(t, oracle.priceProviders, provider.ProviderHuobi)
+	require.Equal(t, []types.CurrencyPair{umeePair}, oracle.providerPairs[provider.ProviderHuobi])
+	require.NotContains(t, oracle.providerPairs, provider.ProviderKraken)
+
+	// the pending prevote is kept
+	require.Equal(t, previousPrevote, oracle.previousPrevote)
+}
+
+func TestUpdateEndpoints(t *testing.T) {
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			CurrencyPairs: []config.CurrencyPair{
+				{
+					Base:      "ATOM",
+					Quote:     "USDT",
+					Providers: []string{provider.ProviderBinance, provider.ProviderKraken},
+				},
+			},
+			Endpoints: []config.ProviderEndpoint{
+				{Name: provider.ProviderBinance, Rest: "http://localhost:8080", Websocket: "localhost:8081"},
+			},
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+		},
+	)
+
+	oracle.priceProviders = map[string]provider.Provider{
+		provider.ProviderBinance: &subscribingProvider{},
+		provider.ProviderKraken:  &subscribingProvider{},
+	}
+
+	binanceStopped, krakenStopped := false, false
+	oracle.providerCancels[provider.ProviderBinance] = func() { binanceStopped = true }
+	oracle.providerCancels[provider.ProviderKraken] = func() { krakenStopped = true }
+
+	binanceEndpoints := config.ProviderEndpoint{
+		Name:      provider.ProviderBinance,
+		Rest:      "http://localhost:9080",
+		Websocket: "localhost:9081",
+	}
+	oracle.UpdateEndpoints([]config.ProviderEndpoint{binanceEndpoints})
+
+	// only providers whose endpoints changed are restarted on the next tick
+	require.True(t, binanceStopped)
+	require.NotContains(t, oracle.priceProviders, provider.ProviderBinance)
+	require.False(t, krakenStopped)
+	require.Contains(t, oracle.priceProviders, provider.ProviderKraken)
+	require.Equal(t, provider.Endpoints{
+		Rest:      binanceEndpoints.Rest,
+		Websocket: binanceEndpoints.Websocket,
+	}, oracle.providerEndpoints[provider.ProviderBinance])
+}
+
+func TestUpdateDeviations(t *testing.T) {
+	oracle := New(zerolog.Nop(), client.OracleClient{}, Options{
+		TVWAPWindow:  testTVWAPWindow,
+		MaxCandleAge: testMaxCandleAge,
+	})
+	require.Equal(t, defaultDeviationFilter, oracle.deviationFilter("UMEE"))
+
+	oracle.UpdateDeviations([]config.Deviation{{Base: "umee", Threshold: "1.5", MinProviders: 2}})
+
+	filter := oracle.deviationFilter("UMEE")
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), filter.threshold)
+	require.Equal(t, 2, filter.minProviders)
+}
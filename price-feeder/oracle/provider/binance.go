@@ -21,6 +21,28 @@ const (
 
 var _ Provider = (*BinanceProvider)(nil)
 
+func init() {
+	Register(Registration{
+		Name: ProviderBinance,
+		Factory: func(
+			ctx context.Context,
+			logger zerolog.Logger,
+			endpoints Endpoints,
+			pairs ...types.CurrencyPair,
+		) (Provider, error) {
+			return NewBinanceProvider(ctx, logger, endpoints, pairs...)
+		},
+		Endpoints: Endpoints{
+			Websocket: "wss://" + binanceHost + binancePath,
+		},
+		Capabilities: Capabilities{
+			Ticker:    true,
+			Candles:   true,
+			WebSocket: true,
+		},
+	})
+}
+
 type (
 	// BinanceProvider defines an Oracle provider implemented by the Binance public
 	// API.
@@ -29,12 +51,13 @@ type (
 	// REF: https://binance-docs.github.io/apidocs/spot/en/#kline-candlestick-streams
 	BinanceProvider struct {
 		wsURL           url.URL
-		wsClient        *websocket.Conn
+		wsClient        *wsConnection
 		logger          zerolog.Logger
 		mtx             sync.RWMutex
 		tickers         map[string]BinanceTicker      // Symbol => BinanceTicker
 		candles         map[string][]BinanceCandle    // Symbol => BinanceCandle
 		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+		reconnectTime   time.Duration                 // delay between two reconnection attempts
 	}
 
 	// BinanceTicker ticker price response. https://pkg.go.dev/encoding/json#Unmarshal
@@ -70,11 +93,16 @@ type (
 	}
 )
 
-func NewBinanceProvider(ctx context.Context, logger zerolog.Logger, pairs ...types.CurrencyPair) (*BinanceProvider, error) {
-	wsURL := url.URL{
-		Scheme: "wss",
-		Host:   binanceHost,
-		Path:   binancePath,
+func NewBinanceProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (*BinanceProvider, error) {
+	endpoints = endpoints.withDefaults(ProviderBinance)
+	wsURL, err := url.Parse(endpoints.Websocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Binance websocket endpoint: %w", err)
 	}
 
 	wsConn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
@@ -83,12 +111,13 @@ func NewBinanceProvider(ctx context.Context, logger zerolog.Logger, pairs ...typ
 	}
 
 	provider := &BinanceProvider{
-		wsURL:           wsURL,
-		wsClient:        wsConn,
+		wsURL:           *wsURL,
+		wsClient:        newWSConnection(wsConn),
 		logger:          logger.With().Str("provider", "binance").Logger(),
 		tickers:         map[string]BinanceTicker{},
 		candles:         map[string][]BinanceCandle{},
 		subscribedPairs: map[string]types.CurrencyPair{},
+		reconnectTime:   defaultReconnectTime,
 	}
 
 	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
@@ -250,7 +279,7 @@ func (p *BinanceProvider) setTickerPair(ticker BinanceTicker) {
 func (p *BinanceProvider) setCandlePair(candle BinanceCandle) {
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
-	staleTime := PastUnixTime(providerCandlePeriod)
+	staleTime := PastUnixTime(CandlePeriod)
 	candleList := []BinanceCandle{}
 	candleList = append(candleList, candle)
 
@@ -272,6 +301,9 @@ func (candle BinanceCandle) toCandlePrice() (CandlePrice, error) {
 }
 
 func (p *BinanceProvider) handleWebSocketMsgs(ctx context.Context) {
+	// release the blocked read once ctx is canceled
+	p.wsClient.CloseOnDone(ctx, p.logger)
+
 	reconnectTicker := time.NewTicker(defaultMaxConnectionTime)
 	defer reconnectTicker.Stop()
 
@@ -282,6 +314,10 @@ func (p *BinanceProvider) handleWebSocketMsgs(ctx context.Context) {
 		case <-time.After(defaultReadNewWSMessage):
 			messageType, bz, err := p.wsClient.ReadMessage()
 			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
 				// if some error occurs continue to try to read the next message.
 				p.logger.Err(err).Msg("could not read message")
 				continue
@@ -296,7 +332,7 @@ func (p *BinanceProvider) handleWebSocketMsgs(ctx context.Context) {
 		case <-reconnectTicker.C:
 			if err := p.reconnect(); err != nil {
 				p.logger.Err(err).Msg("error reconnecting")
-				p.keepReconnecting()
+				p.keepReconnecting(ctx)
 			}
 		}
 	}
@@ -316,29 +352,36 @@ func (p *BinanceProvider) reconnect() error {
 	if err != nil {
 		return fmt.Errorf("error reconnect to binance websocket: %w", err)
 	}
-	p.wsClient = wsConn
+	p.wsClient.Set(wsConn)
 
 	currencyPairs := p.subscribedPairsToSlice()
 	return p.subscribeChannels(currencyPairs...)
 }
 
-// keepReconnecting keeps trying to reconnect if an error occurs in reconnect.
-func (p *BinanceProvider) keepReconnecting() {
-	reconnectTicker := time.NewTicker(defaultReconnectTime)
+// keepReconnecting keeps trying to reconnect if an error occurs in reconnect, until
+// ctx is canceled.
+func (p *BinanceProvider) keepReconnecting(ctx context.Context) {
+	reconnectTicker := time.NewTicker(p.reconnectTime)
 	defer reconnectTicker.Stop()
 	connectionTries := 1
 
-	for time := range reconnectTicker.C {
-		if err := p.reconnect(); err != nil {
-			p.logger.Err(err).Msgf("attempted to reconnect %d times at %s", connectionTries, time.String())
-			continue
-		}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case time := <-reconnectTicker.C:
+			if err := p.reconnect(); err != nil {
+				p.logger.Err(err).Msgf("attempted to reconnect %d times at %s", connectionTries, time.String())
+				continue
+			}
 
-		if connectionTries > maxReconnectionTries {
-			p.logger.Warn().Msgf("failed to reconnect %d times", connectionTries)
+			if connectionTries > maxReconnectionTries {
+				p.logger.Warn().Msgf("failed to reconnect %d times", connectionTries)
+			}
+			connectionTries++
+			return
 		}
-		connectionTries++
-		return
 	}
 }
 
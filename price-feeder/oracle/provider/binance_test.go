@@ -2,16 +2,23 @@ package provider
 
 import (
 	"context"
+	"net/url"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
 )
 
 func TestBinanceProvider_GetTickerPrices(t *testing.T) {
-	p, err := NewBinanceProvider(context.TODO(), zerolog.Nop(), types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, endpoints := newReplayServer(t, ProviderBinance, nil, replay.Options{})
+	p, err := NewBinanceProvider(ctx, zerolog.Nop(), endpoints, types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
 	require.NoError(t, err)
 
 	t.Run("valid_request_single_ticker", func(t *testing.T) {
@@ -78,3 +85,63 @@ func TestBinanceCurrencyPairToBinancePair(t *testing.T) {
 	binanceSymbol := currencyPairToBinanceTickerPair(cp)
 	require.Equal(t, binanceSymbol, "atomusdt@ticker")
 }
+
+func TestBinanceProvider_Replay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	server, endpoints := newReplayServer(t, ProviderBinance, loadFrames(t, ProviderBinance), replay.Options{})
+
+	p, err := NewBinanceProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := p.GetCandlePrices(pair)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	prices, err := p.GetTickerPrices(pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("27.45"), prices["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1423577.12"), prices["ATOMUSDT"].Volume)
+
+	candles, err := p.GetCandlePrices(pair)
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDT"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("27.46"), candles["ATOMUSDT"][0].Price)
+	require.Equal(t, int64(1649999999999), candles["ATOMUSDT"][0].TimeStamp)
+
+	received := server.Received()
+	require.Len(t, received, 2)
+	require.JSONEq(t, `{"method":"SUBSCRIBE","params":["atomusdt@ticker"],"id":1}`, string(received[0]))
+	require.JSONEq(t, `{"method":"SUBSCRIBE","params":["atomusdt@kline_1m"],"id":1}`, string(received[1]))
+}
+
+func TestBinanceProvider_KeepReconnectingCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, endpoints := newReplayServer(t, ProviderBinance, nil, replay.Options{})
+	p, err := NewBinanceProvider(ctx, zerolog.Nop(), endpoints, types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+	require.NoError(t, err)
+
+	// reconnecting to an unreachable endpoint stops once ctx is canceled
+	p.wsURL = url.URL{Scheme: "ws", Host: "127.0.0.1:1"}
+	p.reconnectTime = time.Millisecond
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.keepReconnecting(ctx)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reconnection not stopped by the canceled context")
+	}
+}
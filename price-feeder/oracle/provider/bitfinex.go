@@ -0,0 +1,677 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+const (
+	bitfinexHost            = "api-pub.bitfinex.com"
+	bitfinexPath            = "/ws/2"
+	bitfinexEventInfo       = "info"
+	bitfinexEventSubscribed = "subscribed"
+	bitfinexEventError      = "error"
+	bitfinexChannelTicker   = "ticker"
+	bitfinexChannelCandles  = "candles"
+	bitfinexCandleKeyPrefix = "trade:1m:"
+	bitfinexHeartbeat       = `"hb"`
+	bitfinexInfoReconnect   = 20051
+
+	// bitfinexMaxChannels is the number of public channels Bitfinex allows per
+	// connection. Every pair is subscribed to two channels.
+	bitfinexMaxChannels = 25
+)
+
+var (
+	_ Provider = (*BitfinexProvider)(nil)
+
+	// bitfinexCurrencies maps the currencies Bitfinex lists under a different
+	// symbol than the other providers.
+	bitfinexCurrencies = map[string]string{
+		"ATOM": "ATO",
+		"USDT": "UST",
+	}
+)
+
+func init() {
+	Register(Registration{
+		Name: ProviderBitfinex,
+		Factory: func(
+			ctx context.Context,
+			logger zerolog.Logger,
+			endpoints Endpoints,
+			pairs ...types.CurrencyPair,
+		) (Provider, error) {
+			return NewBitfinexProvider(ctx, logger, endpoints, pairs...)
+		},
+		Endpoints: Endpoints{
+			Websocket: "wss://" + bitfinexHost + bitfinexPath,
+		},
+		Capabilities: Capabilities{
+			Ticker:    true,
+			Candles:   true,
+			WebSocket: true,
+			MaxPairs:  bitfinexMaxChannels / 2,
+		},
+	})
+}
+
+type (
+	// BitfinexProvider defines an Oracle provider implemented by the Bitfinex
+	// public API.
+	//
+	// REF: https://docs.bitfinex.com/docs/ws-public
+	BitfinexProvider struct {
+		wsURL           url.URL
+		wsClient        *wsConnection
+		logger          zerolog.Logger
+		mtx             sync.RWMutex
+		channels        map[int64]BitfinexChannel     // ChanID => BitfinexChannel
+		tickers         map[string]TickerPrice        // Symbol => TickerPrice
+		candles         map[string][]BitfinexCandle   // Symbol => []BitfinexCandle
+		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+		reconnectTime   time.Duration                 // delay between two reconnection attempts
+	}
+
+	// BitfinexChannel defines a channel subscribed by the provider, as data
+	// messages only carry the channel id.
+	BitfinexChannel struct {
+		Name   string // ticker|candles
+		Symbol string // currency pair symbol ex.: ATOMUSDT
+	}
+
+	// BitfinexCandle candle response from Bitfinex candles channel.
+	// REF: https://docs.bitfinex.com/reference#ws-public-candles
+	BitfinexCandle struct {
+		Close     string // Close price during this period
+		TimeStamp int64  // Unix timestamp in milliseconds
+		Volume    string // Volume during this period
+		Symbol    string // Symbol for this candle
+	}
+
+	// BitfinexEvent defines an event message sent by Bitfinex.
+	BitfinexEvent struct {
+		Event    string                `json:"event"`   // info|subscribed|error
+		Channel  string                `json:"channel"` // ticker|candles
+		ChanID   int64                 `json:"chanId"`  // id of the subscribed channel
+		Symbol   string                `json:"symbol"`  // ticker symbol ex.: tATOUSD
+		Key      string                `json:"key"`     // candles key ex.: trade:1m:tATOUSD
+		Msg      string                `json:"msg"`     // error description
+		Code     int64                 `json:"code"`    // error or info code
+		Platform BitfinexPlatformState `json:"platform"`
+	}
+
+	// BitfinexPlatformState defines the platform state of an info event.
+	BitfinexPlatformState struct {
+		Status int64 `json:"status"` // 1 operative, 0 maintenance
+	}
+
+	// BitfinexSubscriptionMsg Msg to subscribe to a single channel.
+	BitfinexSubscriptionMsg struct {
+		Event   string `json:"event"`            // subscribe
+		Channel string `json:"channel"`          // ticker|candles
+		Symbol  string `json:"symbol,omitempty"` // ticker symbol ex.: tATOUSD
+		Key     string `json:"key,omitempty"`    // candles key ex.: trade:1m:tATOUSD
+	}
+)
+
+// NewBitfinexProvider returns a new Bitfinex provider with the WS connection
+// and msg handler.
+func NewBitfinexProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (*BitfinexProvider, error) {
+	endpoints = endpoints.withDefaults(ProviderBitfinex)
+	wsURL, err := url.Parse(endpoints.Websocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Bitfinex websocket endpoint: %w", err)
+	}
+
+	wsConn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Bitfinex websocket: %w", err)
+	}
+
+	provider := &BitfinexProvider{
+		wsURL:           *wsURL,
+		wsClient:        newWSConnection(wsConn),
+		logger:          logger.With().Str("provider", "bitfinex").Logger(),
+		channels:        map[int64]BitfinexChannel{},
+		tickers:         map[string]TickerPrice{},
+		candles:         map[string][]BitfinexCandle{},
+		subscribedPairs: map[string]types.CurrencyPair{},
+		reconnectTime:   defaultReconnectTime,
+	}
+
+	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
+		return nil, err
+	}
+
+	go provider.handleWebSocketMsgs(ctx)
+
+	return provider, nil
+}
+
+// GetTickerPrices returns the tickerPrices based on the saved map.
+func (p *BitfinexProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+
+	for _, cp := range pairs {
+		key := cp.String()
+		tickerPrice, ok := p.tickers[key]
+		if !ok {
+			return nil, fmt.Errorf("failed to get ticker price for %s", key)
+		}
+		tickerPrices[key] = tickerPrice
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the candlePrices based on the saved map.
+func (p *BitfinexProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+
+	for _, cp := range pairs {
+		key := cp.String()
+		candlePrice, err := p.getCandlePrices(key)
+		if err != nil {
+			return nil, err
+		}
+		candlePrices[key] = candlePrice
+	}
+
+	return candlePrices, nil
+}
+
+// SubscribeCurrencyPairs subscribe all currency pairs into ticker and candle channels.
+func (p *BitfinexProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	if err := p.subscribeChannels(cps...); err != nil {
+		return err
+	}
+
+	p.setSubscribedPairs(cps...)
+	return nil
+}
+
+// subscribeChannels subscribe all currency pairs into ticker and candle
+// channels. Bitfinex requires a message per channel.
+func (p *BitfinexProvider) subscribeChannels(cps ...types.CurrencyPair) error {
+	for _, cp := range cps {
+		symbol := currencyPairToBitfinexSymbol(cp)
+
+		if err := p.wsClient.WriteJSON(newBitfinexTickerSubscriptionMsg(symbol)); err != nil {
+			return err
+		}
+		if err := p.wsClient.WriteJSON(newBitfinexCandleSubscriptionMsg(symbol)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// subscribedPairsToSlice returns the map of subscribed pairs as slice
+func (p *BitfinexProvider) subscribedPairsToSlice() []types.CurrencyPair {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return mapPairsToSlice(p.subscribedPairs)
+}
+
+func (candle BitfinexCandle) toCandlePrice() (CandlePrice, error) {
+	return newCandlePrice(
+		"Bitfinex",
+		candle.Symbol,
+		candle.Close,
+		candle.Volume,
+		candle.TimeStamp,
+	)
+}
+
+func (p *BitfinexProvider) getCandlePrices(key string) ([]CandlePrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	candles, ok := p.candles[key]
+	if !ok {
+		return []CandlePrice{}, fmt.Errorf("failed to get candle prices for %s", key)
+	}
+
+	candleList := []CandlePrice{}
+	for _, candle := range candles {
+		cp, err := candle.toCandlePrice()
+		if err != nil {
+			return []CandlePrice{}, err
+		}
+		candleList = append(candleList, cp)
+	}
+	return candleList, nil
+}
+
+// handleWebSocketMsgs receive all the messages from the provider and controls the
+// reconnect function to the web socket.
+func (p *BitfinexProvider) handleWebSocketMsgs(ctx context.Context) {
+	// release the blocked read once ctx is canceled
+	p.wsClient.CloseOnDone(ctx, p.logger)
+
+	reconnectTicker := time.NewTicker(defaultMaxConnectionTime)
+	defer reconnectTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(defaultReadNewWSMessage):
+			messageType, bz, err := p.wsClient.ReadMessage()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				if websocket.IsCloseError(err, websocket.CloseAbnormalClosure) {
+					p.logger.Err(err).Msg("WebSocket closed unexpectedly")
+					p.keepReconnecting(ctx)
+					continue
+				}
+
+				// if some error occurs continue to try to read the next message.
+				p.logger.Err(err).Msg("could not read message")
+				if err := p.ping(); err != nil {
+					p.logger.Err(err).Msg("failed to send ping")
+					p.keepReconnecting(ctx)
+				}
+				continue
+			}
+
+			if len(bz) == 0 {
+				continue
+			}
+
+			p.messageReceived(ctx, messageType, bz)
+
+		case <-reconnectTicker.C:
+			if err := p.reconnect(); err != nil {
+				p.logger.Err(err).Msg("attempted to reconnect")
+				p.keepReconnecting(ctx)
+			}
+		}
+	}
+}
+
+// messageReceived handles any message sent by the provider. Events are JSON
+// objects, while channel data are arrays prefixed by the channel id.
+func (p *BitfinexProvider) messageReceived(ctx context.Context, messageType int, bz []byte) {
+	if messageType != websocket.TextMessage {
+		return
+	}
+
+	var bitfinexEvent BitfinexEvent
+	if err := json.Unmarshal(bz, &bitfinexEvent); err == nil {
+		p.messageReceivedEvent(ctx, bitfinexEvent)
+		return
+	}
+
+	if err := p.messageReceivedChannelData(bz); err != nil {
+		p.logger.Debug().Err(err).Msg("unable to unmarshal channel data")
+	}
+}
+
+// messageReceivedEvent handles the event messages, keeping track of the
+// subscribed channels.
+func (p *BitfinexProvider) messageReceivedEvent(ctx context.Context, event BitfinexEvent) {
+	switch event.Event {
+	case bitfinexEventSubscribed:
+		symbol := event.Symbol
+		if event.Channel == bitfinexChannelCandles {
+			symbol = strings.TrimPrefix(event.Key, bitfinexCandleKeyPrefix)
+		}
+
+		p.setChannel(event.ChanID, BitfinexChannel{
+			Name:   event.Channel,
+			Symbol: bitfinexSymbolToCurrencyPairSymbol(symbol),
+		})
+
+	case bitfinexEventError:
+		p.logger.Error().Int64("code", event.Code).Msg(event.Msg)
+
+	case bitfinexEventInfo:
+		switch {
+		case event.Code == bitfinexInfoReconnect:
+			p.logger.Warn().Msg(event.Msg)
+			p.keepReconnecting(ctx)
+
+		// the platform status is sent on connection, 0 means maintenance.
+		case event.Code == 0 && event.Platform.Status == 0:
+			p.logger.Warn().Msg("platform in maintenance")
+			p.keepReconnecting(ctx)
+		}
+	}
+}
+
+// messageReceivedChannelData handles the ticker and candle data msg.
+func (p *BitfinexProvider) messageReceivedChannelData(bz []byte) error {
+	// the provider response is an array with the channel id in the first
+	// position and the data in the second one.
+	// REF: https://docs.bitfinex.com/docs/ws-general#subscribe-to-channels
+	var dataMessage []json.RawMessage
+	if err := json.Unmarshal(bz, &dataMessage); err != nil {
+		return err
+	}
+
+	if len(dataMessage) != 2 {
+		return fmt.Errorf("received an unexpected structure")
+	}
+
+	if string(dataMessage[1]) == bitfinexHeartbeat {
+		return nil
+	}
+
+	var chanID int64
+	if err := json.Unmarshal(dataMessage[0], &chanID); err != nil {
+		return err
+	}
+
+	channel, ok := p.getChannel(chanID)
+	if !ok {
+		return fmt.Errorf("received data for an unknown channel %d", chanID)
+	}
+
+	switch channel.Name {
+	case bitfinexChannelTicker:
+		return p.messageReceivedTickerPrice(channel.Symbol, dataMessage[1])
+
+	case bitfinexChannelCandles:
+		return p.messageReceivedCandles(channel.Symbol, dataMessage[1])
+	}
+
+	return fmt.Errorf("received data for an unexpected channel %s", channel.Name)
+}
+
+// messageReceivedTickerPrice handles the ticker price msg.
+// REF: https://docs.bitfinex.com/reference#ws-public-ticker
+func (p *BitfinexProvider) messageReceivedTickerPrice(symbol string, bz []byte) error {
+	ticker, err := unmarshalBitfinexNumbers(bz)
+	if err != nil {
+		return err
+	}
+
+	// [BID, BID_SIZE, ASK, ASK_SIZE, DAILY_CHANGE, DAILY_CHANGE_RELATIVE,
+	// LAST_PRICE, VOLUME, HIGH, LOW]
+	if len(ticker) != 10 {
+		return fmt.Errorf("wrong number of fields in ticker")
+	}
+
+	tickerPrice, err := newTickerPrice("Bitfinex", symbol, ticker[6], ticker[7])
+	if err != nil {
+		return err
+	}
+
+	p.setTickerPair(symbol, tickerPrice)
+	return nil
+}
+
+// messageReceivedCandles handles the candle msg, which is either a snapshot
+// with a list of candles or a single candle update.
+// REF: https://docs.bitfinex.com/reference#ws-public-candles
+func (p *BitfinexProvider) messageReceivedCandles(symbol string, bz []byte) error {
+	var candlesBz []json.RawMessage
+	if bytes.HasPrefix(bytes.TrimSpace(bz), []byte("[[")) {
+		if err := json.Unmarshal(bz, &candlesBz); err != nil {
+			return err
+		}
+	} else {
+		candlesBz = []json.RawMessage{bz}
+	}
+
+	for _, candleBz := range candlesBz {
+		candle, err := unmarshalBitfinexNumbers(candleBz)
+		if err != nil {
+			return err
+		}
+
+		// [MTS, OPEN, CLOSE, HIGH, LOW, VOLUME]
+		if len(candle) != 6 {
+			return fmt.Errorf("wrong number of fields in candle")
+		}
+
+		timeStamp, err := strconv.ParseInt(candle[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to convert time to int: %w", err)
+		}
+
+		p.setCandlePair(BitfinexCandle{
+			Close:     candle[2],
+			TimeStamp: timeStamp,
+			Volume:    candle[5],
+			Symbol:    symbol,
+		})
+	}
+
+	return nil
+}
+
+// reconnect closes the last WS connection and create a new one.
+func (p *BitfinexProvider) reconnect() error {
+	p.wsClient.Close()
+	p.logger.Debug().Msg("trying to reconnect")
+
+	wsConn, _, err := websocket.DefaultDialer.Dial(p.wsURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to Bitfinex websocket: %w", err)
+	}
+	p.wsClient.Set(wsConn)
+	p.resetChannels()
+
+	currencyPairs := p.subscribedPairsToSlice()
+	return p.subscribeChannels(currencyPairs...)
+}
+
+// keepReconnecting keeps trying to reconnect if an error occurs in reconnect, until
+// ctx is canceled.
+func (p *BitfinexProvider) keepReconnecting(ctx context.Context) {
+	reconnectTicker := time.NewTicker(p.reconnectTime)
+	defer reconnectTicker.Stop()
+	connectionTries := 1
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case time := <-reconnectTicker.C:
+			if err := p.reconnect(); err != nil {
+				p.logger.Err(err).Msgf("attempted to reconnect %d times at %s", connectionTries, time.String())
+				continue
+			}
+
+			if connectionTries > maxReconnectionTries {
+				p.logger.Warn().Msgf("failed to reconnect %d times", connectionTries)
+			}
+			connectionTries++
+			return
+		}
+	}
+}
+
+// setChannel sets a subscribed channel to the map thread safe by the mutex.
+func (p *BitfinexProvider) setChannel(chanID int64, channel BitfinexChannel) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.channels[chanID] = channel
+}
+
+func (p *BitfinexProvider) getChannel(chanID int64) (BitfinexChannel, bool) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	channel, ok := p.channels[chanID]
+	return channel, ok
+}
+
+// resetChannels forgets the channels of a previous connection, since channel
+// ids are assigned per connection.
+func (p *BitfinexProvider) resetChannels() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.channels = map[int64]BitfinexChannel{}
+}
+
+// setTickerPair sets an ticker to the map thread safe by the mutex.
+func (p *BitfinexProvider) setTickerPair(symbol string, ticker TickerPrice) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.tickers[symbol] = ticker
+}
+
+func (p *BitfinexProvider) setCandlePair(candle BitfinexCandle) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	staleTime := PastUnixTime(CandlePeriod)
+	if candle.TimeStamp <= staleTime {
+		return
+	}
+
+	candleList := []BitfinexCandle{}
+	candleList = append(candleList, candle)
+	for _, c := range p.candles[candle.Symbol] {
+		// updates of the current candle replace the previous one.
+		if staleTime < c.TimeStamp && c.TimeStamp != candle.TimeStamp {
+			candleList = append(candleList, c)
+		}
+	}
+	p.candles[candle.Symbol] = candleList
+}
+
+// ping to check websocket connection.
+func (p *BitfinexProvider) ping() error {
+	return p.wsClient.WriteMessage(websocket.PingMessage, ping)
+}
+
+// setSubscribedPairs sets N currency pairs to the map of subscribed pairs.
+func (p *BitfinexProvider) setSubscribedPairs(cps ...types.CurrencyPair) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, cp := range cps {
+		p.subscribedPairs[cp.String()] = cp
+	}
+}
+
+// unmarshalBitfinexNumbers unmarshals an array of numbers into their decimal
+// string representation, keeping their original precision unless they are
+// sent in exponent notation.
+func unmarshalBitfinexNumbers(bz []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var numbers []json.Number
+	if err := decoder.Decode(&numbers); err != nil {
+		return nil, err
+	}
+
+	decimals := make([]string, len(numbers))
+	for i, n := range numbers {
+		decimals[i] = n.String()
+		if !strings.ContainsAny(decimals[i], "eE") {
+			continue
+		}
+
+		f, err := n.Float64()
+		if err != nil {
+			return nil, err
+		}
+		decimals[i] = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	return decimals, nil
+}
+
+// newBitfinexTickerSubscriptionMsg returns a new ticker subscription Msg.
+func newBitfinexTickerSubscriptionMsg(symbol string) BitfinexSubscriptionMsg {
+	return BitfinexSubscriptionMsg{
+		Event:   "subscribe",
+		Channel: bitfinexChannelTicker,
+		Symbol:  symbol,
+	}
+}
+
+// newBitfinexCandleSubscriptionMsg returns a new candle subscription Msg.
+func newBitfinexCandleSubscriptionMsg(symbol string) BitfinexSubscriptionMsg {
+	return BitfinexSubscriptionMsg{
+		Event:   "subscribe",
+		Channel: bitfinexChannelCandles,
+		Key:     bitfinexCandleKeyPrefix + symbol,
+	}
+}
+
+// currencyPairToBitfinexSymbol receives a currency pair and returns the
+// bitfinex trading symbol, ex.: tATOUSD. Currencies longer than three letters
+// are separated by a colon, ex.: tLUNA:UST.
+func currencyPairToBitfinexSymbol(cp types.CurrencyPair) string {
+	base := toBitfinexCurrency(cp.Base)
+	quote := toBitfinexCurrency(cp.Quote)
+
+	if len(base) > 3 || len(quote) > 3 {
+		return "t" + base + ":" + quote
+	}
+
+	return "t" + base + quote
+}
+
+// bitfinexSymbolToCurrencyPairSymbol receives a bitfinex trading symbol
+// ex.: tATOUSD and returns the currency pair symbol ATOMUSD.
+func bitfinexSymbolToCurrencyPairSymbol(symbol string) string {
+	symbol = strings.TrimPrefix(symbol, "t")
+
+	var base, quote string
+	switch {
+	case strings.Contains(symbol, ":"):
+		parts := strings.SplitN(symbol, ":", 2)
+		base, quote = parts[0], parts[1]
+
+	case len(symbol) == 6:
+		base, quote = symbol[:3], symbol[3:]
+
+	default:
+		return symbol
+	}
+
+	return fromBitfinexCurrency(base) + fromBitfinexCurrency(quote)
+}
+
+func toBitfinexCurrency(currency string) string {
+	currency = strings.ToUpper(currency)
+	if bitfinexCurrency, ok := bitfinexCurrencies[currency]; ok {
+		return bitfinexCurrency
+	}
+
+	return currency
+}
+
+func fromBitfinexCurrency(bitfinexCurrency string) string {
+	for currency, c := range bitfinexCurrencies {
+		if c == bitfinexCurrency {
+			return currency
+		}
+	}
+
+	return bitfinexCurrency
+}
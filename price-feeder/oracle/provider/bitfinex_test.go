@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+// newTestBitfinexProvider returns a BitfinexProvider without a websocket
+// connection, to be fed with recorded messages.
+func newTestBitfinexProvider() *BitfinexProvider {
+	return &BitfinexProvider{
+		logger:          zerolog.Nop(),
+		channels:        map[int64]BitfinexChannel{},
+		tickers:         map[string]TickerPrice{},
+		candles:         map[string][]BitfinexCandle{},
+		subscribedPairs: map[string]types.CurrencyPair{},
+	}
+}
+
+func TestBitfinexProvider_GetTickerPrices(t *testing.T) {
+	p := newTestBitfinexProvider()
+
+	for _, msg := range []string{
+		`{"event":"info","version":2,"serverId":"9c4d8b2a-6f3e","platform":{"status":1}}`,
+		`{"event":"subscribed","channel":"ticker","chanId":224555,"symbol":"tATOUSD","pair":"ATOUSD"}`,
+		`{"event":"subscribed","channel":"ticker","chanId":224556,"symbol":"tLUNA:UST","pair":"LUNA:UST"}`,
+		`[224555,[34.68,1520.2,34.7,2245.7,0.83,0.0245,34.69,2396974.02,35.02,33.12]]`,
+		`[224556,[41.34,120.5,41.36,98.1,-1.2,-0.028,41.35,1.5e-05,42.1,40.8]]`,
+		`[224555,"hb"]`,
+	} {
+		p.messageReceived(context.Background(), websocket.TextMessage, []byte(msg))
+	}
+
+	t.Run("valid_request_multi_ticker", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(
+			types.CurrencyPair{Base: "ATOM", Quote: "USD"},
+			types.CurrencyPair{Base: "LUNA", Quote: "USDT"},
+		)
+		require.NoError(t, err)
+		require.Len(t, prices, 2)
+		require.Equal(t, sdk.MustNewDecFromStr("34.69"), prices["ATOMUSD"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("2396974.02"), prices["ATOMUSD"].Volume)
+		require.Equal(t, sdk.MustNewDecFromStr("41.35"), prices["LUNAUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("0.000015"), prices["LUNAUSDT"].Volume)
+	})
+
+	t.Run("invalid_request_invalid_ticker", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "FOO", Quote: "BAR"})
+		require.Error(t, err)
+		require.Equal(t, "failed to get ticker price for FOOBAR", err.Error())
+		require.Nil(t, prices)
+	})
+}
+
+func TestBitfinexProvider_GetCandlePrices(t *testing.T) {
+	p := newTestBitfinexProvider()
+	now := time.Now().Truncate(time.Minute).UnixNano() / int64(time.Millisecond)
+	minute := time.Minute.Milliseconds()
+	stale := now - 2*CandlePeriod.Milliseconds()
+
+	for _, msg := range []string{
+		`{"event":"subscribed","channel":"candles","chanId":343351,"key":"trade:1m:tATOUSD"}`,
+		fmt.Sprintf(
+			`[343351,[[%d,34.5,34.6,34.65,34.49,120.3],[%d,34.4,34.5,34.55,34.38,98.7],[%d,30,30.1,30.2,29.9,1]]]`,
+			now-minute, now-2*minute, stale,
+		),
+		fmt.Sprintf(`[343351,[%d,34.6,34.62,34.7,34.58,12.1]]`, now),
+		// updates of the current candle replace the previous one.
+		fmt.Sprintf(`[343351,[%d,34.6,34.69,34.7,34.58,20.5]]`, now),
+	} {
+		p.messageReceived(context.Background(), websocket.TextMessage, []byte(msg))
+	}
+
+	candles, err := p.GetCandlePrices(types.CurrencyPair{Base: "ATOM", Quote: "USD"})
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSD"], 3)
+	require.Equal(t, sdk.MustNewDecFromStr("34.69"), candles["ATOMUSD"][0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("20.5"), candles["ATOMUSD"][0].Volume)
+	require.Equal(t, now, candles["ATOMUSD"][0].TimeStamp)
+
+	_, err = p.GetCandlePrices(types.CurrencyPair{Base: "FOO", Quote: "BAR"})
+	require.EqualError(t, err, "failed to get candle prices for FOOBAR")
+}
+
+func TestBitfinexProvider_UnknownChannel(t *testing.T) {
+	p := newTestBitfinexProvider()
+
+	err := p.messageReceivedChannelData([]byte(`[1,[34.68,1520.2,34.7,2245.7,0.83,0.0245,34.69,2396974.02,35.02,33.12]]`))
+	require.EqualError(t, err, "received data for an unknown channel 1")
+
+	p.messageReceived(context.Background(), websocket.TextMessage, []byte(`{"event":"error","msg":"symbol: invalid","code":10300}`))
+	require.Empty(t, p.channels)
+}
+
+func TestCurrencyPairToBitfinexSymbol(t *testing.T) {
+	testCases := []struct {
+		cp     types.CurrencyPair
+		symbol string
+	}{
+		{types.CurrencyPair{Base: "ATOM", Quote: "USD"}, "tATOUSD"},
+		{types.CurrencyPair{Base: "BTC", Quote: "USDT"}, "tBTCUST"},
+		{types.CurrencyPair{Base: "LUNA", Quote: "USDT"}, "tLUNA:UST"},
+	}
+
+	for _, tc := range testCases {
+		symbol := currencyPairToBitfinexSymbol(tc.cp)
+		require.Equal(t, tc.symbol, symbol)
+		require.Equal(t, tc.cp.String(), bitfinexSymbolToCurrencyPairSymbol(symbol))
+	}
+}
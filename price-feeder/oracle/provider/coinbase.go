@@ -0,0 +1,462 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+const (
+	coinbaseHost          = "ws-feed.exchange.coinbase.com"
+	coinbaseTypeTicker    = "ticker"
+	coinbaseTypeMatch     = "match"
+	coinbaseTypeLastMatch = "last_match"
+	coinbaseTypeError     = "error"
+)
+
+var _ Provider = (*CoinbaseProvider)(nil)
+
+func init() {
+	Register(Registration{
+		Name: ProviderCoinbase,
+		Factory: func(
+			ctx context.Context,
+			logger zerolog.Logger,
+			endpoints Endpoints,
+			pairs ...types.CurrencyPair,
+		) (Provider, error) {
+			return NewCoinbaseProvider(ctx, logger, endpoints, pairs...)
+		},
+		Endpoints: Endpoints{
+			Websocket: "wss://" + coinbaseHost,
+		},
+		Capabilities: Capabilities{
+			Ticker:    true,
+			Candles:   true,
+			WebSocket: true,
+		},
+	})
+}
+
+type (
+	// CoinbaseProvider defines an Oracle provider implemented by the Coinbase
+	// Exchange public API. Coinbase does not stream candles, so the trades
+	// received on the matches channel are used as candles instead.
+	//
+	// REF: https://docs.cloud.coinbase.com/exchange/docs/websocket-overview
+	CoinbaseProvider struct {
+		wsURL           url.URL
+		wsClient        *wsConnection
+		logger          zerolog.Logger
+		mtx             sync.RWMutex
+		tickers         map[string]TickerPrice        // Symbol => TickerPrice
+		trades          map[string][]CoinbaseTrade    // Symbol => []CoinbaseTrade
+		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+		reconnectTime   time.Duration                 // delay between two reconnection attempts
+	}
+
+	// CoinbaseMsg wraps the type of any message sent by Coinbase.
+	CoinbaseMsg struct {
+		Type string `json:"type"` // ticker|match|last_match|subscriptions|error
+	}
+
+	// CoinbaseTicker defines the ticker message sent on the ticker channel.
+	// REF: https://docs.cloud.coinbase.com/exchange/docs/websocket-channels#ticker-channel
+	CoinbaseTicker struct {
+		ProductID string `json:"product_id"` // ex.: ATOM-USDT
+		Price     string `json:"price"`      // last trade price
+		Volume    string `json:"volume_24h"` // volume over the last 24 hours
+	}
+
+	// CoinbaseTrade defines a trade message sent on the matches channel.
+	// REF: https://docs.cloud.coinbase.com/exchange/docs/websocket-channels#match
+	CoinbaseTrade struct {
+		ProductID string `json:"product_id"` // ex.: ATOM-USDT
+		Price     string `json:"price"`      // trade price
+		Size      string `json:"size"`       // trade size
+		Time      string `json:"time"`       // RFC3339 time of the trade
+		TimeStamp int64  `json:"-"`          // Unix time of the trade in milliseconds
+	}
+
+	// CoinbaseErrResponse defines the error message sent by Coinbase.
+	CoinbaseErrResponse struct {
+		Message string `json:"message"`
+		Reason  string `json:"reason"`
+	}
+
+	// CoinbaseSubscriptionMsg Msg to subscribe to all the pairs at once.
+	CoinbaseSubscriptionMsg struct {
+		Type       string   `json:"type"`        // subscribe/unsubscribe
+		ProductIDs []string `json:"product_ids"` // product ids ex.: ATOM-USDT
+		Channels   []string `json:"channels"`    // channels ex.: ticker, matches
+	}
+)
+
+// NewCoinbaseProvider returns a new Coinbase provider with the WS connection
+// and msg handler.
+func NewCoinbaseProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (*CoinbaseProvider, error) {
+	endpoints = endpoints.withDefaults(ProviderCoinbase)
+	wsURL, err := url.Parse(endpoints.Websocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Coinbase websocket endpoint: %w", err)
+	}
+
+	wsConn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Coinbase websocket: %w", err)
+	}
+
+	provider := &CoinbaseProvider{
+		wsURL:           *wsURL,
+		wsClient:        newWSConnection(wsConn),
+		logger:          logger.With().Str("provider", "coinbase").Logger(),
+		tickers:         map[string]TickerPrice{},
+		trades:          map[string][]CoinbaseTrade{},
+		subscribedPairs: map[string]types.CurrencyPair{},
+		reconnectTime:   defaultReconnectTime,
+	}
+
+	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
+		return nil, err
+	}
+
+	go provider.handleWebSocketMsgs(ctx)
+
+	return provider, nil
+}
+
+// GetTickerPrices returns the tickerPrices based on the saved map.
+func (p *CoinbaseProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+
+	for _, cp := range pairs {
+		key := cp.String()
+		tickerPrice, ok := p.tickers[key]
+		if !ok {
+			return nil, fmt.Errorf("failed to get ticker price for %s", key)
+		}
+		tickerPrices[key] = tickerPrice
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns the candlePrices built from the trades received
+// within the candle period.
+func (p *CoinbaseProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	candlePrices := make(map[string][]CandlePrice, len(pairs))
+
+	for _, cp := range pairs {
+		key := cp.String()
+		candlePrice, err := p.getCandlePrices(key)
+		if err != nil {
+			return nil, err
+		}
+		candlePrices[key] = candlePrice
+	}
+
+	return candlePrices, nil
+}
+
+// SubscribeCurrencyPairs subscribe all currency pairs into ticker and matches
+// channels.
+func (p *CoinbaseProvider) SubscribeCurrencyPairs(cps ...types.CurrencyPair) error {
+	if err := p.subscribeChannels(cps...); err != nil {
+		return err
+	}
+
+	p.setSubscribedPairs(cps...)
+	return nil
+}
+
+// subscribeChannels subscribe all currency pairs into ticker and matches
+// channels.
+func (p *CoinbaseProvider) subscribeChannels(cps ...types.CurrencyPair) error {
+	productIDs := make([]string, len(cps))
+
+	for i, cp := range cps {
+		productIDs[i] = currencyPairToCoinbaseProductID(cp)
+	}
+
+	return p.wsClient.WriteJSON(newCoinbaseSubscriptionMsg(productIDs...))
+}
+
+// subscribedPairsToSlice returns the map of subscribed pairs as slice
+func (p *CoinbaseProvider) subscribedPairsToSlice() []types.CurrencyPair {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return mapPairsToSlice(p.subscribedPairs)
+}
+
+func (trade CoinbaseTrade) toCandlePrice() (CandlePrice, error) {
+	return newCandlePrice(
+		"Coinbase",
+		coinbaseProductIDToCurrencyPairSymbol(trade.ProductID),
+		trade.Price,
+		trade.Size,
+		trade.TimeStamp,
+	)
+}
+
+func (p *CoinbaseProvider) getCandlePrices(key string) ([]CandlePrice, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	trades, ok := p.trades[key]
+	if !ok {
+		return []CandlePrice{}, fmt.Errorf("failed to get candle prices for %s", key)
+	}
+
+	candleList := []CandlePrice{}
+	for _, trade := range trades {
+		cp, err := trade.toCandlePrice()
+		if err != nil {
+			return []CandlePrice{}, err
+		}
+		candleList = append(candleList, cp)
+	}
+	return candleList, nil
+}
+
+// handleWebSocketMsgs receive all the messages from the provider and controls the
+// reconnect function to the web socket.
+func (p *CoinbaseProvider) handleWebSocketMsgs(ctx context.Context) {
+	// release the blocked read once ctx is canceled
+	p.wsClient.CloseOnDone(ctx, p.logger)
+
+	reconnectTicker := time.NewTicker(defaultMaxConnectionTime)
+	defer reconnectTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(defaultReadNewWSMessage):
+			messageType, bz, err := p.wsClient.ReadMessage()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				if websocket.IsCloseError(err, websocket.CloseAbnormalClosure) {
+					p.logger.Err(err).Msg("WebSocket closed unexpectedly")
+					p.keepReconnecting(ctx)
+					continue
+				}
+
+				// if some error occurs continue to try to read the next message.
+				p.logger.Err(err).Msg("could not read message")
+				if err := p.ping(); err != nil {
+					p.logger.Err(err).Msg("failed to send ping")
+					p.keepReconnecting(ctx)
+				}
+				continue
+			}
+
+			if len(bz) == 0 {
+				continue
+			}
+
+			p.messageReceived(messageType, bz)
+
+		case <-reconnectTicker.C:
+			if err := p.reconnect(); err != nil {
+				p.logger.Err(err).Msg("attempted to reconnect")
+				p.keepReconnecting(ctx)
+			}
+		}
+	}
+}
+
+// messageReceived handles any message sent by the provider.
+func (p *CoinbaseProvider) messageReceived(messageType int, bz []byte) {
+	if messageType != websocket.TextMessage {
+		return
+	}
+
+	var coinbaseMsg CoinbaseMsg
+	if err := json.Unmarshal(bz, &coinbaseMsg); err != nil {
+		p.logger.Debug().Err(err).Msg("unable to unmarshal message")
+		return
+	}
+
+	switch coinbaseMsg.Type {
+	case coinbaseTypeTicker:
+		if err := p.messageReceivedTickerPrice(bz); err != nil {
+			p.logger.Debug().Err(err).Msg("unable to unmarshal ticker")
+		}
+
+	case coinbaseTypeMatch, coinbaseTypeLastMatch:
+		if err := p.messageReceivedTrade(bz); err != nil {
+			p.logger.Debug().Err(err).Msg("unable to unmarshal trade")
+		}
+
+	case coinbaseTypeError:
+		var errResponse CoinbaseErrResponse
+		if err := json.Unmarshal(bz, &errResponse); err != nil {
+			p.logger.Debug().Err(err).Msg("unable to unmarshal error")
+			return
+		}
+		p.logger.Error().Str("reason", errResponse.Reason).Msg(errResponse.Message)
+	}
+}
+
+// messageReceivedTickerPrice handles the ticker price msg.
+func (p *CoinbaseProvider) messageReceivedTickerPrice(bz []byte) error {
+	var coinbaseTicker CoinbaseTicker
+	if err := json.Unmarshal(bz, &coinbaseTicker); err != nil {
+		return err
+	}
+
+	currencyPairSymbol := coinbaseProductIDToCurrencyPairSymbol(coinbaseTicker.ProductID)
+
+	tickerPrice, err := newTickerPrice("Coinbase", currencyPairSymbol, coinbaseTicker.Price, coinbaseTicker.Volume)
+	if err != nil {
+		return err
+	}
+
+	p.setTickerPair(currencyPairSymbol, tickerPrice)
+	return nil
+}
+
+// messageReceivedTrade handles the trade msg.
+func (p *CoinbaseProvider) messageReceivedTrade(bz []byte) error {
+	var coinbaseTrade CoinbaseTrade
+	if err := json.Unmarshal(bz, &coinbaseTrade); err != nil {
+		return err
+	}
+
+	tradeTime, err := time.Parse(time.RFC3339Nano, coinbaseTrade.Time)
+	if err != nil {
+		return fmt.Errorf("unable to parse trade time: %w", err)
+	}
+	coinbaseTrade.TimeStamp = tradeTime.UnixNano() / int64(time.Millisecond)
+
+	p.setTrade(coinbaseTrade)
+	return nil
+}
+
+// reconnect closes the last WS connection and create a new one.
+func (p *CoinbaseProvider) reconnect() error {
+	p.wsClient.Close()
+	p.logger.Debug().Msg("trying to reconnect")
+
+	wsConn, _, err := websocket.DefaultDialer.Dial(p.wsURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("error connecting to Coinbase websocket: %w", err)
+	}
+	p.wsClient.Set(wsConn)
+
+	currencyPairs := p.subscribedPairsToSlice()
+	return p.subscribeChannels(currencyPairs...)
+}
+
+// keepReconnecting keeps trying to reconnect if an error occurs in reconnect, until
+// ctx is canceled.
+func (p *CoinbaseProvider) keepReconnecting(ctx context.Context) {
+	reconnectTicker := time.NewTicker(p.reconnectTime)
+	defer reconnectTicker.Stop()
+	connectionTries := 1
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case time := <-reconnectTicker.C:
+			if err := p.reconnect(); err != nil {
+				p.logger.Err(err).Msgf("attempted to reconnect %d times at %s", connectionTries, time.String())
+				continue
+			}
+
+			if connectionTries > maxReconnectionTries {
+				p.logger.Warn().Msgf("failed to reconnect %d times", connectionTries)
+			}
+			connectionTries++
+			return
+		}
+	}
+}
+
+// setTickerPair sets an ticker to the map thread safe by the mutex.
+func (p *CoinbaseProvider) setTickerPair(symbol string, ticker TickerPrice) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.tickers[symbol] = ticker
+}
+
+// setTrade appends a trade to the trades of its pair, dropping the trades older
+// than the candle period. Trades are streamed in order, so the expired ones are
+// trimmed from the front of the list.
+func (p *CoinbaseProvider) setTrade(trade CoinbaseTrade) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	staleTime := PastUnixTime(CandlePeriod)
+	if trade.TimeStamp <= staleTime {
+		return
+	}
+
+	symbol := coinbaseProductIDToCurrencyPairSymbol(trade.ProductID)
+	trades := append(p.trades[symbol], trade)
+
+	expired := 0
+	for expired < len(trades) && trades[expired].TimeStamp <= staleTime {
+		expired++
+	}
+	p.trades[symbol] = trades[expired:]
+}
+
+// ping to check websocket connection.
+func (p *CoinbaseProvider) ping() error {
+	return p.wsClient.WriteMessage(websocket.PingMessage, ping)
+}
+
+// setSubscribedPairs sets N currency pairs to the map of subscribed pairs.
+func (p *CoinbaseProvider) setSubscribedPairs(cps ...types.CurrencyPair) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, cp := range cps {
+		p.subscribedPairs[cp.String()] = cp
+	}
+}
+
+// newCoinbaseSubscriptionMsg returns a new subscription Msg to the ticker and
+// matches channels.
+func newCoinbaseSubscriptionMsg(productIDs ...string) CoinbaseSubscriptionMsg {
+	return CoinbaseSubscriptionMsg{
+		Type:       "subscribe",
+		ProductIDs: productIDs,
+		Channels:   []string{"ticker", "matches"},
+	}
+}
+
+// currencyPairToCoinbaseProductID receives a currency pair and returns the
+// coinbase product id ATOM-USDT.
+func currencyPairToCoinbaseProductID(cp types.CurrencyPair) string {
+	return strings.ToUpper(cp.Base + "-" + cp.Quote)
+}
+
+// coinbaseProductIDToCurrencyPairSymbol receives a coinbase product id
+// ex.: ATOM-USDT and returns the currency pair symbol ATOMUSDT.
+func coinbaseProductIDToCurrencyPairSymbol(productID string) string {
+	return strings.Replace(productID, "-", "", -1)
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+// newTestCoinbaseProvider returns a CoinbaseProvider without a websocket
+// connection, to be fed with recorded messages.
+func newTestCoinbaseProvider() *CoinbaseProvider {
+	return &CoinbaseProvider{
+		logger:          zerolog.Nop(),
+		tickers:         map[string]TickerPrice{},
+		trades:          map[string][]CoinbaseTrade{},
+		subscribedPairs: map[string]types.CurrencyPair{},
+	}
+}
+
+func TestCoinbaseProvider_GetTickerPrices(t *testing.T) {
+	p := newTestCoinbaseProvider()
+
+	t.Run("valid_request_single_ticker", func(t *testing.T) {
+		p.messageReceived(websocket.TextMessage, []byte(`{
+			"type": "ticker",
+			"sequence": 1864637617,
+			"product_id": "ATOM-USDT",
+			"price": "34.69",
+			"open_24h": "33.86",
+			"volume_24h": "2396974.02",
+			"low_24h": "33.12",
+			"high_24h": "35.02",
+			"volume_30d": "51393862.58",
+			"best_bid": "34.68",
+			"best_ask": "34.70",
+			"side": "buy",
+			"time": "2022-03-16T17:23:45.123456Z",
+			"trade_id": 4271930,
+			"last_size": "12.5"
+		}`))
+
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+		require.NoError(t, err)
+		require.Len(t, prices, 1)
+		require.Equal(t, sdk.MustNewDecFromStr("34.69"), prices["ATOMUSDT"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("2396974.02"), prices["ATOMUSDT"].Volume)
+	})
+
+	t.Run("invalid_request_invalid_ticker", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "FOO", Quote: "BAR"})
+		require.Error(t, err)
+		require.Equal(t, "failed to get ticker price for FOOBAR", err.Error())
+		require.Nil(t, prices)
+	})
+}
+
+func TestCoinbaseProvider_GetCandlePrices(t *testing.T) {
+	p := newTestCoinbaseProvider()
+	now := time.Now().UTC()
+
+	for i, msg := range []string{
+		`{"type":"last_match","trade_id":4271920,"maker_order_id":"ac928c66","taker_order_id":"132fb6ae",` +
+			`"side":"sell","size":"5.1","price":"34.60","product_id":"ATOM-USDT","sequence":1864637600,"time":"%s"}`,
+		`{"type":"match","trade_id":4271930,"maker_order_id":"bd028c66","taker_order_id":"412fb6ae",` +
+			`"side":"buy","size":"12.5","price":"34.69","product_id":"ATOM-USDT","sequence":1864637617,"time":"%s"}`,
+		// trades older than the candle period are dropped.
+		`{"type":"match","trade_id":4271000,"maker_order_id":"cd028c66","taker_order_id":"512fb6ae",` +
+			`"side":"buy","size":"1","price":"30","product_id":"ATOM-USDT","sequence":1864630000,"time":"%s"}`,
+	} {
+		tradeTime := now.Add(time.Duration(i) * time.Second)
+		if i == 2 {
+			tradeTime = now.Add(-2 * CandlePeriod)
+		}
+		p.messageReceived(websocket.TextMessage, []byte(fmt.Sprintf(msg, tradeTime.Format(time.RFC3339Nano))))
+	}
+
+	candles, err := p.GetCandlePrices(types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDT"], 2)
+	require.Equal(t, sdk.MustNewDecFromStr("34.60"), candles["ATOMUSDT"][0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("34.69"), candles["ATOMUSDT"][1].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), candles["ATOMUSDT"][1].Volume)
+	require.Equal(t, now.Add(time.Second).UnixNano()/int64(time.Millisecond), candles["ATOMUSDT"][1].TimeStamp)
+
+	// trades expiring meanwhile are trimmed once a new trade is received.
+	p.trades["ATOMUSDT"][0].TimeStamp = PastUnixTime(2 * CandlePeriod)
+	p.messageReceived(websocket.TextMessage, []byte(fmt.Sprintf(
+		`{"type":"match","trade_id":4271940,"maker_order_id":"ce028c66","taker_order_id":"612fb6ae",`+
+			`"side":"sell","size":"2","price":"34.70","product_id":"ATOM-USDT","sequence":1864637620,"time":"%s"}`,
+		now.Add(2*time.Second).Format(time.RFC3339Nano),
+	)))
+
+	candles, err = p.GetCandlePrices(types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDT"], 2)
+	require.Equal(t, sdk.MustNewDecFromStr("34.69"), candles["ATOMUSDT"][0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("34.70"), candles["ATOMUSDT"][1].Price)
+
+	_, err = p.GetCandlePrices(types.CurrencyPair{Base: "FOO", Quote: "BAR"})
+	require.EqualError(t, err, "failed to get candle prices for FOOBAR")
+}
+
+func TestCoinbaseProvider_MessageReceived_Error(t *testing.T) {
+	p := newTestCoinbaseProvider()
+
+	p.messageReceived(websocket.TextMessage, []byte(
+		`{"type":"error","message":"Failed to subscribe","reason":"FOO-BAR is not a valid product"}`,
+	))
+	p.messageReceived(websocket.TextMessage, []byte(
+		`{"type":"subscriptions","channels":[{"name":"ticker","product_ids":["ATOM-USDT"]}]}`,
+	))
+
+	require.Empty(t, p.tickers)
+	require.Empty(t, p.trades)
+}
+
+func TestCurrencyPairToCoinbaseProductID(t *testing.T) {
+	cp := types.CurrencyPair{Base: "atom", Quote: "usdt"}
+	require.Equal(t, "ATOM-USDT", currencyPairToCoinbaseProductID(cp))
+}
+
+func TestCoinbaseProductIDToCurrencyPairSymbol(t *testing.T) {
+	cp := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	require.Equal(t, cp.String(), coinbaseProductIDToCurrencyPairSymbol("ATOM-USDT"))
+}
@@ -22,6 +22,29 @@ const (
 
 var _ Provider = (*GateProvider)(nil)
 
+func init() {
+	Register(Registration{
+		Name: ProviderGate,
+		Factory: func(
+			ctx context.Context,
+			logger zerolog.Logger,
+			endpoints Endpoints,
+			pairs ...types.CurrencyPair,
+		) (Provider, error) {
+			return NewGateProvider(ctx, logger, endpoints, pairs...)
+		},
+		Endpoints: Endpoints{
+			Websocket: "wss://" + gateHost + gatePath,
+		},
+		Capabilities: Capabilities{
+			Ticker:    true,
+			Candles:   true,
+			WebSocket: true,
+			MaxPairs:  1,
+		},
+	})
+}
+
 type (
 	// GateProvider defines an Oracle provider implemented by the Gate public
 	// API.
@@ -29,7 +52,7 @@ type (
 	// REF: https://www.gate.io/docs/websocket/index.html
 	GateProvider struct {
 		wsURL           url.URL
-		wsClient        *websocket.Conn
+		wsClient        *wsConnection
 		logger          zerolog.Logger
 		reconnectTimer  *time.Ticker
 		mtx             sync.RWMutex
@@ -92,11 +115,16 @@ type (
 )
 
 // NewGateProvider creates a new GateProvider.
-func NewGateProvider(ctx context.Context, logger zerolog.Logger, pairs ...types.CurrencyPair) (*GateProvider, error) {
-	wsURL := url.URL{
-		Scheme: "wss",
-		Host:   gateHost,
-		Path:   gatePath,
+func NewGateProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (*GateProvider, error) {
+	endpoints = endpoints.withDefaults(ProviderGate)
+	wsURL, err := url.Parse(endpoints.Websocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Gate websocket endpoint: %w", err)
 	}
 
 	wsConn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
@@ -105,15 +133,15 @@ func NewGateProvider(ctx context.Context, logger zerolog.Logger, pairs ...types.
 	}
 
 	provider := &GateProvider{
-		wsURL:           wsURL,
-		wsClient:        wsConn,
+		wsURL:           *wsURL,
+		wsClient:        newWSConnection(wsConn),
 		logger:          logger.With().Str("provider", "gate").Logger(),
 		reconnectTimer:  time.NewTicker(gatePingCheck),
 		tickers:         map[string]GateTicker{},
 		candles:         map[string][]GateCandle{},
 		subscribedPairs: map[string]types.CurrencyPair{},
 	}
-	provider.wsClient.SetPongHandler(provider.pongHandler)
+	wsConn.SetPongHandler(provider.pongHandler)
 
 	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
 		return nil, err
@@ -250,6 +278,9 @@ func (p *GateProvider) getTickerPrice(cp types.CurrencyPair) (TickerPrice, error
 }
 
 func (p *GateProvider) handleReceivedTickers(ctx context.Context) {
+	// release the blocked read once ctx is canceled
+	p.wsClient.CloseOnDone(ctx, p.logger)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -257,6 +288,10 @@ func (p *GateProvider) handleReceivedTickers(ctx context.Context) {
 		case <-time.After(defaultReadNewWSMessage):
 			messageType, bz, err := p.wsClient.ReadMessage()
 			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
 				// if some error occurs continue to try to read the next message.
 				p.logger.Err(err).Msg("could not read message")
 				if err := p.ping(); err != nil {
@@ -323,6 +358,9 @@ func (p *GateProvider) messageReceivedTickerPrice(bz []byte) error {
 	if tickerMessage.Method != "ticker.update" {
 		return fmt.Errorf("message is not a ticker update")
 	}
+	if len(tickerMessage.Params) != 2 {
+		return fmt.Errorf("wrong number of params in ticker update")
+	}
 
 	tickerBz, err := json.Marshal(tickerMessage.Params[1])
 	if err != nil {
@@ -361,11 +399,11 @@ func (gc *GateCandle) UnmarshalParams(params [][]interface{}) error {
 		return fmt.Errorf("wrong number of fields in candle")
 	}
 
-	time := int64(tmp[0].(float64))
-	if time == 0 {
+	time, ok := tmp[0].(float64)
+	if !ok || time == 0 {
 		return fmt.Errorf("time field must be a float")
 	}
-	gc.TimeStamp = time
+	gc.TimeStamp = int64(time)
 
 	close, ok := tmp[1].(string)
 	if !ok {
@@ -422,7 +460,7 @@ func (p *GateProvider) setCandlePair(candle GateCandle) {
 	defer p.mtx.Unlock()
 	// convert gate timestamp seconds -> milliseconds
 	candle.TimeStamp = candle.TimeStamp * int64(time.Second/time.Millisecond)
-	staleTime := PastUnixTime(providerCandlePeriod)
+	staleTime := PastUnixTime(CandlePeriod)
 	candleList := []GateCandle{}
 
 	candleList = append(candleList, candle)
@@ -477,7 +515,7 @@ func (p *GateProvider) reconnect() error {
 		return fmt.Errorf("error reconnecting to Gate websocket: %w", err)
 	}
 	wsConn.SetPongHandler(p.pongHandler)
-	p.wsClient = wsConn
+	p.wsClient.Set(wsConn)
 
 	currencyPairs := p.subscribedPairsToSlice()
 	return p.SubscribeCurrencyPairs(currencyPairs...)
@@ -3,15 +3,21 @@ package provider
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
 )
 
 func TestGateProvider_GetTickerPrices(t *testing.T) {
-	p, err := NewGateProvider(context.TODO(), zerolog.Nop(), types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, endpoints := newReplayServer(t, ProviderGate, nil, replay.Options{})
+	p, err := NewGateProvider(ctx, zerolog.Nop(), endpoints, types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
 	require.NoError(t, err)
 
 	t.Run("valid_request_single_ticker", func(t *testing.T) {
@@ -78,3 +84,58 @@ func TestGateCurrencyPairToGatePair(t *testing.T) {
 	GateSymbol := currencyPairToGatePair(cp)
 	require.Equal(t, GateSymbol, "ATOM_USDT")
 }
+
+func TestGateProvider_Replay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	server, endpoints := newReplayServer(t, ProviderGate, loadFrames(t, ProviderGate), replay.Options{})
+
+	p, err := NewGateProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := p.GetCandlePrices(pair)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	prices, err := p.GetTickerPrices(pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("27.45"), prices["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1423577.12"), prices["ATOMUSDT"].Volume)
+
+	// the candle with a string timestamp is skipped
+	candles, err := p.GetCandlePrices(pair)
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDT"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("27.46"), candles["ATOMUSDT"][0].Price)
+	require.Equal(t, int64(1649999940000), candles["ATOMUSDT"][0].TimeStamp)
+
+	require.Equal(t, 1, server.Connections())
+}
+
+func TestGateProvider_ReplayReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// a failed subscription makes the provider reconnect and subscribe again
+	frames := append(
+		loadFrames(t, ProviderGate),
+		replay.TextFrame(`{"error":{"code":2,"message":"invalid argument"},"result":{"status":"failed"},"id":1}`),
+	)
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	server, endpoints := newReplayServer(t, ProviderGate, frames, replay.Options{})
+
+	_, err := NewGateProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return server.Connections() > 1 && len(server.Received()) >= 4
+	}, 5*time.Second, 10*time.Millisecond)
+
+	received := server.Received()
+	require.JSONEq(t, string(received[0]), string(received[2]))
+	require.JSONEq(t, string(received[1]), string(received[3]))
+}
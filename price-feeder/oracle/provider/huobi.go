@@ -26,6 +26,28 @@ const (
 
 var _ Provider = (*HuobiProvider)(nil)
 
+func init() {
+	Register(Registration{
+		Name: ProviderHuobi,
+		Factory: func(
+			ctx context.Context,
+			logger zerolog.Logger,
+			endpoints Endpoints,
+			pairs ...types.CurrencyPair,
+		) (Provider, error) {
+			return NewHuobiProvider(ctx, logger, endpoints, pairs...)
+		},
+		Endpoints: Endpoints{
+			Websocket: "wss://" + huobiHost + huobiPath,
+		},
+		Capabilities: Capabilities{
+			Ticker:    true,
+			Candles:   true,
+			WebSocket: true,
+		},
+	})
+}
+
 type (
 	// HuobiProvider defines an Oracle provider implemented by the Huobi public
 	// API.
@@ -34,7 +56,7 @@ type (
 	// REF: https://huobiapi.github.io/docs/spot/v1/en/#get-klines-candles
 	HuobiProvider struct {
 		wsURL           url.URL
-		wsClient        *websocket.Conn
+		wsClient        *wsConnection
 		logger          zerolog.Logger
 		mtx             sync.RWMutex
 		tickers         map[string]HuobiTicker        // market.$symbol.ticker => HuobiTicker
@@ -77,11 +99,16 @@ type (
 )
 
 // NewHuobiProvider returns a new Huobi provider with the WS connection and msg handler.
-func NewHuobiProvider(ctx context.Context, logger zerolog.Logger, pairs ...types.CurrencyPair) (*HuobiProvider, error) {
-	wsURL := url.URL{
-		Scheme: "wss",
-		Host:   huobiHost,
-		Path:   huobiPath,
+func NewHuobiProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (*HuobiProvider, error) {
+	endpoints = endpoints.withDefaults(ProviderHuobi)
+	wsURL, err := url.Parse(endpoints.Websocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Huobi websocket endpoint: %w", err)
 	}
 
 	wsConn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
@@ -90,8 +117,8 @@ func NewHuobiProvider(ctx context.Context, logger zerolog.Logger, pairs ...types
 	}
 
 	provider := &HuobiProvider{
-		wsURL:           wsURL,
-		wsClient:        wsConn,
+		wsURL:           *wsURL,
+		wsClient:        newWSConnection(wsConn),
 		logger:          logger.With().Str("provider", "huobi").Logger(),
 		tickers:         map[string]HuobiTicker{},
 		candles:         map[string][]HuobiCandle{},
@@ -187,6 +214,9 @@ func (p *HuobiProvider) subscribedPairsToSlice() []types.CurrencyPair {
 }
 
 func (p *HuobiProvider) handleWebSocketMsgs(ctx context.Context) {
+	// release the blocked read once ctx is canceled
+	p.wsClient.CloseOnDone(ctx, p.logger)
+
 	reconnectTicker := time.NewTicker(huobiReconnectTime)
 	for {
 		select {
@@ -195,6 +225,10 @@ func (p *HuobiProvider) handleWebSocketMsgs(ctx context.Context) {
 		case <-time.After(defaultReadNewWSMessage):
 			messageType, bz, err := p.wsClient.ReadMessage()
 			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
 				// If some error occurs, check if connection is alive
 				// and continue to try to read the next message.
 				p.logger.Err(err).Msg("failed to read message")
@@ -303,7 +337,7 @@ func (p *HuobiProvider) setCandlePair(candle HuobiCandle) {
 	defer p.mtx.Unlock()
 	// convert huobi timestamp seconds -> milliseconds
 	candle.Tick.TimeStamp = candle.Tick.TimeStamp * int64(time.Second/time.Millisecond)
-	staleTime := PastUnixTime(providerCandlePeriod)
+	staleTime := PastUnixTime(CandlePeriod)
 	candleList := []HuobiCandle{}
 	candleList = append(candleList, candle)
 
@@ -324,7 +358,7 @@ func (p *HuobiProvider) reconnect() error {
 	if err != nil {
 		return fmt.Errorf("error reconnecting to Huobi websocket: %w", err)
 	}
-	p.wsClient = wsConn
+	p.wsClient.Set(wsConn)
 
 	currencyPairs := p.subscribedPairsToSlice()
 	return p.subscribeChannels(currencyPairs...)
@@ -1,18 +1,26 @@
 package provider
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
 )
 
 func TestHuobiProvider_GetTickerPrices(t *testing.T) {
-	p, err := NewHuobiProvider(context.TODO(), zerolog.Nop(), types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, endpoints := newReplayServer(t, ProviderHuobi, nil, replay.Options{})
+	p, err := NewHuobiProvider(ctx, zerolog.Nop(), endpoints, types.CurrencyPair{Base: "ATOM", Quote: "USDT"})
 	require.NoError(t, err)
 
 	t.Run("valid_request_single_ticker", func(t *testing.T) {
@@ -85,3 +93,88 @@ func TestHuobiCurrencyPairToHuobiPair(t *testing.T) {
 	binanceSymbol := currencyPairToHuobiTickerPair(cp)
 	require.Equal(t, binanceSymbol, "market.atomusdt.ticker")
 }
+
+// huobiFrames returns the gzip compressed frames Huobi sends to a subscriber of
+// the ATOMUSDT ticker and candle channels, along with malformed ones.
+func huobiFrames(t *testing.T) []replay.Frame {
+	frames := []replay.Frame{
+		replay.BinaryFrame([]byte("not gzip")),
+		replay.TextFrame(`{"status":"ok"}`),
+	}
+
+	for _, msg := range []string{
+		`{"ping":1650000000000}`,
+		`{"ch":"market.atomusdt.ticker","ts":1650000000000,"tick":{"lastPrice":"27.45"}}`,
+		`{"ch":"market.atomusdt.ticker","ts":1650000000000,"tick":{"lastPrice":27.45,"vol":1423577.12}}`,
+		`{"ch":"market.atomusdt.kline.1min","ts":1650000000000,"tick":{"id":1649999940,"close":27.46,"vol":120.5}}`,
+	} {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, err := w.Write([]byte(msg))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		frames = append(frames, replay.BinaryFrame(buf.Bytes()))
+	}
+
+	return frames
+}
+
+func TestHuobiProvider_Replay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	server, endpoints := newReplayServer(t, ProviderHuobi, huobiFrames(t), replay.Options{})
+
+	p, err := NewHuobiProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := p.GetCandlePrices(pair)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	prices, err := p.GetTickerPrices(pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("27.45"), prices["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1423577.12"), prices["ATOMUSDT"].Volume)
+
+	candles, err := p.GetCandlePrices(pair)
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDT"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("27.46"), candles["ATOMUSDT"][0].Price)
+	require.Equal(t, int64(1649999940000), candles["ATOMUSDT"][0].TimeStamp)
+
+	// the heartbeat is answered with a matching pong
+	require.Contains(t, receivedMessages(server), `{"pong":1650000000000}`)
+}
+
+func TestHuobiProvider_ReplayReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	frames := huobiFrames(t)
+	server, endpoints := newReplayServer(t, ProviderHuobi, frames, replay.Options{
+		Interval:  10 * time.Millisecond,
+		DropAfter: len(frames),
+	})
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	_, err := NewHuobiProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return server.Connections() > 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		subscriptions := 0
+		for _, msg := range receivedMessages(server) {
+			if msg == `{"sub":"market.atomusdt.ticker"}` {
+				subscriptions++
+			}
+		}
+		return subscriptions > 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -23,6 +23,28 @@ const (
 
 var _ Provider = (*KrakenProvider)(nil)
 
+func init() {
+	Register(Registration{
+		Name: ProviderKraken,
+		Factory: func(
+			ctx context.Context,
+			logger zerolog.Logger,
+			endpoints Endpoints,
+			pairs ...types.CurrencyPair,
+		) (Provider, error) {
+			return NewKrakenProvider(ctx, logger, endpoints, pairs...)
+		},
+		Endpoints: Endpoints{
+			Websocket: "wss://" + krakenHost,
+		},
+		Capabilities: Capabilities{
+			Ticker:    true,
+			Candles:   true,
+			WebSocket: true,
+		},
+	})
+}
+
 type (
 	// KrakenProvider defines an Oracle provider implemented by the Kraken public
 	// API.
@@ -30,12 +52,13 @@ type (
 	// REF: https://docs.kraken.com/websockets/#overview
 	KrakenProvider struct {
 		wsURL           url.URL
-		wsClient        *websocket.Conn
+		wsClient        *wsConnection
 		logger          zerolog.Logger
 		mtx             sync.RWMutex
 		tickers         map[string]TickerPrice        // Symbol => TickerPrice
 		candles         map[string][]KrakenCandle     // Symbol => KrakenCandle
 		subscribedPairs map[string]types.CurrencyPair // Symbol => types.CurrencyPair
+		reconnectTime   time.Duration                 // delay between two reconnection attempts
 	}
 
 	// KrakenTicker ticker price response from Kraken ticker channel.
@@ -85,10 +108,28 @@ type (
 )
 
 // NewKrakenProvider returns a new Kraken provider with the WS connection and msg handler.
-func NewKrakenProvider(ctx context.Context, logger zerolog.Logger, pairs ...types.CurrencyPair) (*KrakenProvider, error) {
-	wsURL := url.URL{
-		Scheme: "wss",
-		Host:   krakenHost,
+func NewKrakenProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (*KrakenProvider, error) {
+	return newKrakenProvider(ctx, logger, endpoints, defaultReconnectTime, pairs...)
+}
+
+// newKrakenProvider returns a new Kraken provider that waits reconnectTime
+// between two reconnection attempts.
+func newKrakenProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	reconnectTime time.Duration,
+	pairs ...types.CurrencyPair,
+) (*KrakenProvider, error) {
+	endpoints = endpoints.withDefaults(ProviderKraken)
+	wsURL, err := url.Parse(endpoints.Websocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kraken websocket endpoint: %w", err)
 	}
 
 	wsConn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
@@ -97,12 +138,13 @@ func NewKrakenProvider(ctx context.Context, logger zerolog.Logger, pairs ...type
 	}
 
 	provider := &KrakenProvider{
-		wsURL:           wsURL,
-		wsClient:        wsConn,
+		wsURL:           *wsURL,
+		wsClient:        newWSConnection(wsConn),
 		logger:          logger.With().Str("provider", "kraken").Logger(),
 		tickers:         map[string]TickerPrice{},
 		candles:         map[string][]KrakenCandle{},
 		subscribedPairs: map[string]types.CurrencyPair{},
+		reconnectTime:   reconnectTime,
 	}
 
 	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
@@ -215,6 +257,9 @@ func (p *KrakenProvider) getCandlePrices(key string) ([]CandlePrice, error) {
 // handleWebSocketMsgs receive all the messages from the provider and controls the
 // reconnect function to the web socket.
 func (p *KrakenProvider) handleWebSocketMsgs(ctx context.Context) {
+	// release the blocked read once ctx is canceled
+	p.wsClient.CloseOnDone(ctx, p.logger)
+
 	reconnectTicker := time.NewTicker(defaultMaxConnectionTime)
 	defer reconnectTicker.Stop()
 
@@ -225,9 +270,13 @@ func (p *KrakenProvider) handleWebSocketMsgs(ctx context.Context) {
 		case <-time.After(defaultReadNewWSMessage):
 			messageType, bz, err := p.wsClient.ReadMessage()
 			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
 				if websocket.IsCloseError(err, websocket.CloseAbnormalClosure) {
 					p.logger.Err(err).Msg("WebSocket closed unexpectedly")
-					p.keepReconnecting()
+					p.keepReconnecting(ctx)
 					continue
 				}
 
@@ -235,7 +284,7 @@ func (p *KrakenProvider) handleWebSocketMsgs(ctx context.Context) {
 				p.logger.Err(err).Msg("could not read message")
 				if err := p.ping(); err != nil {
 					p.logger.Err(err).Msg("failed to send ping")
-					p.keepReconnecting()
+					p.keepReconnecting(ctx)
 				}
 				continue
 			}
@@ -244,19 +293,19 @@ func (p *KrakenProvider) handleWebSocketMsgs(ctx context.Context) {
 				continue
 			}
 
-			p.messageReceived(messageType, bz)
+			p.messageReceived(ctx, messageType, bz)
 
 		case <-reconnectTicker.C:
 			if err := p.reconnect(); err != nil {
 				p.logger.Err(err).Msg("attempted to reconnect")
-				p.keepReconnecting()
+				p.keepReconnecting(ctx)
 			}
 		}
 	}
 }
 
 // messageReceived handles any message sent by the provider.
-func (p *KrakenProvider) messageReceived(messageType int, bz []byte) {
+func (p *KrakenProvider) messageReceived(ctx context.Context, messageType int, bz []byte) {
 	if messageType != websocket.TextMessage {
 		return
 	}
@@ -268,7 +317,7 @@ func (p *KrakenProvider) messageReceived(messageType int, bz []byte) {
 	} else {
 		switch krakenEvent.Event {
 		case krakenEventSystemStatus:
-			p.messageReceivedSystemStatus(bz)
+			p.messageReceivedSystemStatus(ctx, bz)
 			return
 		case krakenEventSubscriptionStatus:
 			p.messageReceivedSubscriptionStatus(bz)
@@ -421,29 +470,36 @@ func (p *KrakenProvider) reconnect() error {
 	if err != nil {
 		return fmt.Errorf("error connecting to Kraken websocket: %w", err)
 	}
-	p.wsClient = wsConn
+	p.wsClient.Set(wsConn)
 
 	currencyPairs := p.subscribedPairsToSlice()
 	return p.subscribeChannels(currencyPairs...)
 }
 
-// keepReconnecting keeps trying to reconnect if an error occurs in recconnect.
-func (p *KrakenProvider) keepReconnecting() {
-	reconnectTicker := time.NewTicker(defaultReconnectTime)
+// keepReconnecting keeps trying to reconnect if an error occurs in reconnect, until
+// ctx is canceled.
+func (p *KrakenProvider) keepReconnecting(ctx context.Context) {
+	reconnectTicker := time.NewTicker(p.reconnectTime)
 	defer reconnectTicker.Stop()
 	connectionTries := 1
 
-	for time := range reconnectTicker.C {
-		if err := p.reconnect(); err != nil {
-			p.logger.Err(err).Msgf("attempted to reconnect %d times at %s", connectionTries, time.String())
-			continue
-		}
+	for {
+		select {
+		case <-ctx.Done():
+			return
 
-		if connectionTries > maxReconnectionTries {
-			p.logger.Warn().Msgf("failed to reconnect %d times", connectionTries)
+		case time := <-reconnectTicker.C:
+			if err := p.reconnect(); err != nil {
+				p.logger.Err(err).Msgf("attempted to reconnect %d times at %s", connectionTries, time.String())
+				continue
+			}
+
+			if connectionTries > maxReconnectionTries {
+				p.logger.Warn().Msgf("failed to reconnect %d times", connectionTries)
+			}
+			connectionTries++
+			return
 		}
-		connectionTries++
-		return
 	}
 }
 
@@ -470,7 +526,7 @@ func (p *KrakenProvider) messageReceivedSubscriptionStatus(bz []byte) {
 
 // messageReceivedSystemStatus handle the system status and try to reconnect if it
 // is not online.
-func (p *KrakenProvider) messageReceivedSystemStatus(bz []byte) {
+func (p *KrakenProvider) messageReceivedSystemStatus(ctx context.Context, bz []byte) {
 	var systemStatus KrakenEventSystemStatus
 	if err := json.Unmarshal(bz, &systemStatus); err != nil {
 		p.logger.Err(err).Msg("could not unmarshal event system status")
@@ -481,7 +537,7 @@ func (p *KrakenProvider) messageReceivedSystemStatus(bz []byte) {
 		return
 	}
 
-	p.keepReconnecting()
+	p.keepReconnecting(ctx)
 }
 
 // setTickerPair sets an ticker to the map thread safe by the mutex.
@@ -496,7 +552,7 @@ func (p *KrakenProvider) setCandlePair(candle KrakenCandle) {
 	defer p.mtx.Unlock()
 	// convert kraken timestamp seconds -> milliseconds
 	candle.TimeStamp = candle.TimeStamp * int64(time.Second/time.Millisecond)
-	staleTime := PastUnixTime(providerCandlePeriod)
+	staleTime := PastUnixTime(CandlePeriod)
 	candleList := []KrakenCandle{}
 
 	candleList = append(candleList, candle)
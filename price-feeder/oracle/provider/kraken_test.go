@@ -3,15 +3,21 @@ package provider
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
 )
 
 func TestKrakenProvider_GetTickerPrices(t *testing.T) {
-	p, err := NewKrakenProvider(context.TODO(), zerolog.Nop(), types.CurrencyPair{Base: "BTC", Quote: "USDT"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, endpoints := newReplayServer(t, ProviderKraken, nil, replay.Options{})
+	p, err := NewKrakenProvider(ctx, zerolog.Nop(), endpoints, types.CurrencyPair{Base: "BTC", Quote: "USDT"})
 	require.NoError(t, err)
 
 	t.Run("valid_request_single_ticker", func(t *testing.T) {
@@ -89,3 +95,63 @@ func TestNormalizeKrakenBTCPair(t *testing.T) {
 	atomSymbol := normalizeKrakenBTCPair("ATOM/USDT")
 	require.Equal(t, atomSymbol, "ATOM/USDT")
 }
+
+func TestKrakenProvider_Replay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	server, endpoints := newReplayServer(t, ProviderKraken, loadFrames(t, ProviderKraken), replay.Options{})
+
+	p, err := NewKrakenProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := p.GetCandlePrices(pair)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	prices, err := p.GetTickerPrices(pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("27.45"), prices["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1423577.12"), prices["ATOMUSDT"].Volume)
+
+	candles, err := p.GetCandlePrices(pair)
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDT"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("27.46"), candles["ATOMUSDT"][0].Price)
+	require.Equal(t, int64(1650000000000), candles["ATOMUSDT"][0].TimeStamp)
+
+	require.Len(t, server.Received(), 2)
+}
+
+func TestKrakenProvider_ReplayKeepReconnecting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// every connection is dropped once the recording was replayed, which the
+	// provider sees as an abnormal closure
+	frames := loadFrames(t, ProviderKraken)
+	server, endpoints := newReplayServer(t, ProviderKraken, frames, replay.Options{
+		Interval:  10 * time.Millisecond,
+		DropAfter: len(frames),
+	})
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	p, err := newKrakenProvider(ctx, zerolog.Nop(), endpoints, 10*time.Millisecond, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return server.Connections() > 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the provider subscribes to the ticker and candle channels on every
+	// connection
+	received := server.Received()
+	require.GreaterOrEqual(t, len(received), 4)
+	require.JSONEq(t, string(received[0]), string(received[2]))
+	require.JSONEq(t, string(received[1]), string(received[3]))
+
+	_, err = p.GetTickerPrices(pair)
+	require.NoError(t, err)
+}
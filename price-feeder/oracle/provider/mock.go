@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/csv"
 	"fmt"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
 	"github.com/umee-network/umee/price-feeder/oracle/types"
 )
 
@@ -18,7 +20,35 @@ const (
 	mockBaseURL = "https://docs.google.com/spreadsheets/d/e/2PACX-1vSuFLjDFs5ajCoVZ8wFXaJ4DV8MkAKBcX2BJzWkLjx9i-jN-IDclePrBByXm1It8jgaZJGvsglUQuZ6/pub?output=csv&gid=0"
 )
 
-var _ Provider = (*MockProvider)(nil)
+var (
+	_ Provider = (*MockProvider)(nil)
+
+	// mockStablecoins defines the USD stablecoins priced at 1 USD by the mock
+	// provider unless the mock document has a rate for them, so that pairs
+	// quoted in them can be converted to USD.
+	mockStablecoins = map[string]struct{}{
+		"USDT": {},
+		"USDC": {},
+	}
+
+	mockStablecoinVolume = sdk.NewDec(1000000)
+)
+
+func init() {
+	Register(Registration{
+		Name: ProviderMock,
+		Factory: func(_ context.Context, _ zerolog.Logger, endpoints Endpoints, _ ...types.CurrencyPair) (Provider, error) {
+			return NewMockProvider(endpoints), nil
+		},
+		Endpoints: Endpoints{
+			Rest: mockBaseURL,
+		},
+		Capabilities: Capabilities{
+			Ticker:  true,
+			Candles: true,
+		},
+	})
+}
 
 type (
 	// MockProvider defines a mocked exchange rate provider using a published
@@ -29,9 +59,9 @@ type (
 	}
 )
 
-func NewMockProvider() *MockProvider {
+func NewMockProvider(endpoints Endpoints) *MockProvider {
 	return &MockProvider{
-		baseURL: mockBaseURL,
+		baseURL: endpoints.withDefaults(ProviderMock).Rest,
 		client: &http.Client{
 			Timeout: defaultTimeout,
 			// the mock provider is the only one which allows redirects
@@ -87,10 +117,18 @@ func (p MockProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]T
 		tickerPrices[ticker] = TickerPrice{Price: price, Volume: volume}
 	}
 
-	for t := range tickerMap {
-		if _, ok := tickerPrices[t]; !ok {
-			return nil, fmt.Errorf("missing exchange rate for %s", t)
+	for _, cp := range pairs {
+		ticker := strings.ToUpper(cp.String())
+		if _, ok := tickerPrices[ticker]; ok {
+			continue
+		}
+
+		if _, ok := mockStablecoins[strings.ToUpper(cp.Base)]; ok && strings.ToUpper(cp.Quote) == "USD" {
+			tickerPrices[ticker] = TickerPrice{Price: sdk.OneDec(), Volume: mockStablecoinVolume}
+			continue
 		}
+
+		return nil, fmt.Errorf("missing exchange rate for %s", ticker)
 	}
 
 	return tickerPrices, nil
@@ -11,7 +11,7 @@ import (
 )
 
 func TestMockProvider_GetTickerPrices(t *testing.T) {
-	mp := NewMockProvider()
+	mp := NewMockProvider(Endpoints{})
 
 	t.Run("valid_request_single_ticker", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
@@ -60,6 +60,31 @@ ATOM,USDC,21.84,1827884.77
 		require.Equal(t, sdk.MustNewDecFromStr("1827884.77"), prices["ATOMUSDC"].Volume)
 	})
 
+	t.Run("valid_request_stablecoins", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			resp := `Base,Quote,Price,Volume
+UMEE,USDT,3.04,1827884.77
+USDC,USD,0.99,1827884.77
+`
+			rw.Write([]byte(resp))
+		}))
+		defer server.Close()
+
+		mp.client = server.Client()
+		mp.baseURL = server.URL
+
+		prices, err := mp.GetTickerPrices(
+			types.CurrencyPair{Base: "USDT", Quote: "USD"},
+			types.CurrencyPair{Base: "USDC", Quote: "USD"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, sdk.OneDec(), prices["USDTUSD"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("0.99"), prices["USDCUSD"].Price)
+
+		_, err = mp.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "USD"})
+		require.EqualError(t, err, "missing exchange rate for ATOMUSD")
+	})
+
 	t.Run("invalid_request_bad_response", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 			require.Equal(t, "/", req.URL.String())
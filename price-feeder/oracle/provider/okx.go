@@ -23,6 +23,28 @@ const (
 
 var _ Provider = (*OkxProvider)(nil)
 
+func init() {
+	Register(Registration{
+		Name: ProviderOkx,
+		Factory: func(
+			ctx context.Context,
+			logger zerolog.Logger,
+			endpoints Endpoints,
+			pairs ...types.CurrencyPair,
+		) (Provider, error) {
+			return NewOkxProvider(ctx, logger, endpoints, pairs...)
+		},
+		Endpoints: Endpoints{
+			Websocket: "wss://" + okxHost + okxPath,
+		},
+		Capabilities: Capabilities{
+			Ticker:    true,
+			Candles:   true,
+			WebSocket: true,
+		},
+	})
+}
+
 type (
 	// OkxProvider defines an Oracle provider implemented by the Okx public
 	// API.
@@ -30,7 +52,7 @@ type (
 	// REF: https://www.okx.com/docs-v5/en/#websocket-api-public-channel-tickers-channel
 	OkxProvider struct {
 		wsURL           url.URL
-		wsClient        *websocket.Conn
+		wsClient        *wsConnection
 		logger          zerolog.Logger
 		reconnectTimer  *time.Ticker
 		mtx             sync.RWMutex
@@ -86,11 +108,16 @@ type (
 )
 
 // NewOkxProvider creates a new OkxProvider.
-func NewOkxProvider(ctx context.Context, logger zerolog.Logger, pairs ...types.CurrencyPair) (*OkxProvider, error) {
-	wsURL := url.URL{
-		Scheme: "wss",
-		Host:   okxHost,
-		Path:   okxPath,
+func NewOkxProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (*OkxProvider, error) {
+	endpoints = endpoints.withDefaults(ProviderOkx)
+	wsURL, err := url.Parse(endpoints.Websocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Okx websocket endpoint: %w", err)
 	}
 
 	wsConn, _, err := websocket.DefaultDialer.Dial(wsURL.String(), nil)
@@ -99,15 +126,15 @@ func NewOkxProvider(ctx context.Context, logger zerolog.Logger, pairs ...types.C
 	}
 
 	provider := &OkxProvider{
-		wsURL:           wsURL,
-		wsClient:        wsConn,
+		wsURL:           *wsURL,
+		wsClient:        newWSConnection(wsConn),
 		logger:          logger.With().Str("provider", "okx").Logger(),
 		reconnectTimer:  time.NewTicker(okxPingCheck),
 		tickers:         map[string]OkxTickerPair{},
 		candles:         map[string][]OkxCandlePair{},
 		subscribedPairs: map[string]types.CurrencyPair{},
 	}
-	provider.wsClient.SetPongHandler(provider.pongHandler)
+	wsConn.SetPongHandler(provider.pongHandler)
 
 	if err := provider.SubscribeCurrencyPairs(pairs...); err != nil {
 		return nil, err
@@ -234,6 +261,9 @@ func (p *OkxProvider) getCandlePrices(cp types.CurrencyPair) ([]CandlePrice, err
 }
 
 func (p *OkxProvider) handleReceivedTickers(ctx context.Context) {
+	// release the blocked read once ctx is canceled
+	p.wsClient.CloseOnDone(ctx, p.logger)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -241,6 +271,10 @@ func (p *OkxProvider) handleReceivedTickers(ctx context.Context) {
 		case <-time.After(defaultReadNewWSMessage):
 			messageType, bz, err := p.wsClient.ReadMessage()
 			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
 				// if some error occurs continue to try to read the next message.
 				p.logger.Err(err).Msg("could not read message")
 				if err := p.ping(); err != nil {
@@ -312,6 +346,11 @@ func (p *OkxProvider) setCandlePair(pairData []string, instID string) {
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 
+	if len(pairData) < 6 {
+		p.logger.Debug().Str("inst_id", instID).Msg("received a candle with missing fields")
+		return
+	}
+
 	ts, err := strconv.ParseInt(pairData[0], 10, 64)
 	if err != nil {
 		return
@@ -323,7 +362,7 @@ func (p *OkxProvider) setCandlePair(pairData []string, instID string) {
 		Volume:    pairData[5],
 		TimeStamp: ts,
 	}
-	staleTime := PastUnixTime(providerCandlePeriod)
+	staleTime := PastUnixTime(CandlePeriod)
 	candleList := []OkxCandlePair{}
 
 	candleList = append(candleList, candle)
@@ -368,7 +407,7 @@ func (p *OkxProvider) reconnect() error {
 		return fmt.Errorf("error reconnecting to Okx websocket: %w", err)
 	}
 	wsConn.SetPongHandler(p.pongHandler)
-	p.wsClient = wsConn
+	p.wsClient.Set(wsConn)
 
 	currencyPairs := p.subscribedPairsToSlice()
 	return p.subscribeChannels(currencyPairs...)
@@ -3,15 +3,21 @@ package provider
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
 )
 
 func TestOkxProvider_GetTickerPrices(t *testing.T) {
-	p, err := NewOkxProvider(context.TODO(), zerolog.Nop(), types.CurrencyPair{Base: "BTC", Quote: "USDT"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, endpoints := newReplayServer(t, ProviderOkx, nil, replay.Options{})
+	p, err := NewOkxProvider(ctx, zerolog.Nop(), endpoints, types.CurrencyPair{Base: "BTC", Quote: "USDT"})
 	require.NoError(t, err)
 
 	t.Run("valid_request_single_ticker", func(t *testing.T) {
@@ -78,3 +84,33 @@ func TestOkxCurrencyPairToOkxPair(t *testing.T) {
 	okxSymbol := currencyPairToOkxPair(cp)
 	require.Equal(t, okxSymbol, "ATOM-USDT")
 }
+
+func TestOkxProvider_Replay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	server, endpoints := newReplayServer(t, ProviderOkx, loadFrames(t, ProviderOkx), replay.Options{})
+
+	p, err := NewOkxProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := p.GetCandlePrices(pair)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	prices, err := p.GetTickerPrices(pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("27.45"), prices["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1423577.12"), prices["ATOMUSDT"].Volume)
+
+	// the candle with missing fields is skipped
+	candles, err := p.GetCandlePrices(pair)
+	require.NoError(t, err)
+	require.Len(t, candles["ATOMUSDT"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("27.46"), candles["ATOMUSDT"][0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("120.5"), candles["ATOMUSDT"][0].Volume)
+
+	require.NotEmpty(t, server.Received())
+}
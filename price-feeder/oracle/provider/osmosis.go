@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
 	"github.com/umee-network/umee/price-feeder/oracle/types"
 )
 
@@ -21,6 +23,22 @@ const (
 
 var _ Provider = (*OsmosisProvider)(nil)
 
+func init() {
+	Register(Registration{
+		Name: ProviderOsmosis,
+		Factory: func(_ context.Context, _ zerolog.Logger, endpoints Endpoints, _ ...types.CurrencyPair) (Provider, error) {
+			return NewOsmosisProvider(endpoints), nil
+		},
+		Endpoints: Endpoints{
+			Rest: osmosisBaseURL,
+		},
+		Capabilities: Capabilities{
+			Ticker:  true,
+			Candles: true,
+		},
+	})
+}
+
 type (
 	// OsmosisProvider defines an Oracle provider implemented by the Osmosis public
 	// API.
@@ -48,16 +66,16 @@ type (
 	}
 )
 
-func NewOsmosisProvider() *OsmosisProvider {
+func NewOsmosisProvider(endpoints Endpoints) *OsmosisProvider {
 	return &OsmosisProvider{
-		baseURL: osmosisBaseURL,
+		baseURL: endpoints.withDefaults(ProviderOsmosis).Rest,
 		client:  newDefaultHTTPClient(),
 	}
 }
 
-func NewOsmosisProviderWithTimeout(timeout time.Duration) *OsmosisProvider {
+func NewOsmosisProviderWithTimeout(endpoints Endpoints, timeout time.Duration) *OsmosisProvider {
 	return &OsmosisProvider{
-		baseURL: osmosisBaseURL,
+		baseURL: endpoints.withDefaults(ProviderOsmosis).Rest,
 		client:  newHTTPClientWithTimeout(timeout),
 	}
 }
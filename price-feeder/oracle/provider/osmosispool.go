@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+const (
+	osmosisPoolBaseURL      = "https://lcd.osmosis.zone"
+	osmosisPoolEndpoint     = "/osmosis/gamm/v1beta1/pools"
+	osmosisPoolTwapEndpoint = "/osmosis/twap/v1beta1/ArithmeticTwapToNow"
+)
+
+var (
+	_ Provider = (*OsmosisPoolProvider)(nil)
+
+	// osmosisPoolVolumeShare defines the share of the liquidity of a pool that
+	// is reported as its volume, as pools do not track their traded volume. It
+	// weighs a pool like an exchange whose daily volume is 1% of the liquidity
+	// of the pool, so that deep pools count more than shallow ones without
+	// outweighing the exchanges trading the same pair.
+	osmosisPoolVolumeShare = sdk.NewDecWithPrec(1, 2)
+
+	// osmosisPoolAssets maps the symbols of the assets traded in the known
+	// Osmosis pools to their denoms on the Osmosis chain.
+	osmosisPoolAssets = map[string]OsmosisPoolAsset{
+		"OSMO": {Denom: "uosmo", Exponent: 6},
+		"ATOM": {Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Exponent: 6},
+		"USDC": {Denom: "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", Exponent: 6},
+	}
+
+	// osmosisPools maps a currency pair symbol to the id of the Osmosis pool
+	// trading it. Pools are looked up in both directions.
+	osmosisPools = map[string]uint64{
+		"ATOMOSMO": 1,
+		"OSMOUSDC": 678,
+	}
+)
+
+func init() {
+	Register(Registration{
+		Name: ProviderOsmosisPool,
+		Factory: func(_ context.Context, _ zerolog.Logger, endpoints Endpoints, _ ...types.CurrencyPair) (Provider, error) {
+			return NewOsmosisPoolProvider(endpoints), nil
+		},
+		Endpoints: Endpoints{
+			Rest: osmosisPoolBaseURL,
+		},
+		Capabilities: Capabilities{
+			Ticker:  true,
+			Candles: true,
+		},
+	})
+}
+
+type (
+	// OsmosisPoolProvider defines an Oracle provider that reads prices from the
+	// state of Osmosis pools through an Osmosis LCD endpoint, without depending
+	// on an indexer. The ticker price is the spot price computed from the pool
+	// reserves and the candle is the TWAP computed by the chain from the pool
+	// accumulators. As pools do not track traded volume, a share of the pool
+	// liquidity is reported as volume.
+	//
+	// REF: https://docs.osmosis.zone/osmosis-core/modules/twap
+	OsmosisPoolProvider struct {
+		baseURL string
+		client  *http.Client
+	}
+
+	// OsmosisPoolAsset defines an asset traded in an Osmosis pool.
+	OsmosisPoolAsset struct {
+		Denom    string
+		Exponent int64
+	}
+
+	// OsmosisPoolResponse defines the response structure for an Osmosis pool
+	// request.
+	OsmosisPoolResponse struct {
+		Pool struct {
+			ID         string `json:"id"`
+			PoolAssets []struct {
+				Token  sdk.Coin `json:"token"`
+				Weight string   `json:"weight"`
+			} `json:"pool_assets"`
+		} `json:"pool"`
+	}
+
+	// OsmosisPoolTwapResponse defines the response structure for an Osmosis
+	// arithmetic TWAP request.
+	OsmosisPoolTwapResponse struct {
+		ArithmeticTwap string `json:"arithmetic_twap"`
+	}
+
+	// osmosisPoolReserves defines the reserves of the base and quote assets of
+	// a currency pair in an Osmosis pool.
+	osmosisPoolReserves struct {
+		Base        sdk.Dec
+		BaseWeight  sdk.Dec
+		Quote       sdk.Dec
+		QuoteWeight sdk.Dec
+	}
+)
+
+func NewOsmosisPoolProvider(endpoints Endpoints) *OsmosisPoolProvider {
+	return &OsmosisPoolProvider{
+		baseURL: endpoints.withDefaults(ProviderOsmosisPool).Rest,
+		client:  newDefaultHTTPClient(),
+	}
+}
+
+// GetTickerPrices returns the spot prices of the given pairs, computed from the
+// reserves of their pools.
+func (p OsmosisPoolProvider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]TickerPrice, error) {
+	tickerPrices := make(map[string]TickerPrice, len(pairs))
+
+	for _, cp := range pairs {
+		poolID, base, quote, err := osmosisPoolForPair(cp)
+		if err != nil {
+			return nil, err
+		}
+
+		reserves, err := p.getPoolReserves(poolID, base, quote)
+		if err != nil {
+			return nil, err
+		}
+
+		tickerPrices[cp.String()] = TickerPrice{
+			Price:  reserves.spotPrice(base, quote),
+			Volume: reserves.volume(base),
+		}
+	}
+
+	return tickerPrices, nil
+}
+
+// GetCandlePrices returns a single candle per pair, priced at the TWAP of the
+// pool over the candle period.
+func (p OsmosisPoolProvider) GetCandlePrices(pairs ...types.CurrencyPair) (map[string][]CandlePrice, error) {
+	candles := make(map[string][]CandlePrice, len(pairs))
+	now := time.Now()
+
+	for _, cp := range pairs {
+		poolID, base, quote, err := osmosisPoolForPair(cp)
+		if err != nil {
+			return nil, err
+		}
+
+		twap, err := p.getTwap(poolID, base, quote, now.Add(-CandlePeriod))
+		if err != nil {
+			return nil, err
+		}
+
+		reserves, err := p.getPoolReserves(poolID, base, quote)
+		if err != nil {
+			return nil, err
+		}
+
+		candles[cp.String()] = []CandlePrice{
+			{
+				Price:     twap,
+				Volume:    reserves.volume(base),
+				TimeStamp: now.UnixNano() / int64(time.Millisecond),
+			},
+		}
+	}
+
+	return candles, nil
+}
+
+// SubscribeCurrencyPairs performs a no-op since the pools are queried on
+// demand.
+func (p OsmosisPoolProvider) SubscribeCurrencyPairs(pairs ...types.CurrencyPair) error {
+	return nil
+}
+
+// getPoolReserves queries the pool and returns the reserves and weights of the
+// given assets.
+func (p OsmosisPoolProvider) getPoolReserves(
+	poolID uint64,
+	base, quote OsmosisPoolAsset,
+) (osmosisPoolReserves, error) {
+	path := fmt.Sprintf("%s%s/%d", p.baseURL, osmosisPoolEndpoint, poolID)
+
+	var poolResp OsmosisPoolResponse
+	if err := p.get(path, &poolResp); err != nil {
+		return osmosisPoolReserves{}, err
+	}
+
+	var reserves osmosisPoolReserves
+	for _, asset := range poolResp.Pool.PoolAssets {
+		weight, err := sdk.NewDecFromStr(asset.Weight)
+		if err != nil {
+			return osmosisPoolReserves{}, fmt.Errorf("failed to parse Osmosis pool %d weight (%s)", poolID, asset.Weight)
+		}
+
+		switch asset.Token.Denom {
+		case base.Denom:
+			reserves.Base = asset.Token.Amount.ToDec()
+			reserves.BaseWeight = weight
+		case quote.Denom:
+			reserves.Quote = asset.Token.Amount.ToDec()
+			reserves.QuoteWeight = weight
+		}
+	}
+
+	if reserves.Base.IsNil() || reserves.Quote.IsNil() {
+		return osmosisPoolReserves{}, fmt.Errorf("pool %d does not trade %s and %s on Osmosis", poolID, base.Denom, quote.Denom)
+	}
+	if !reserves.Base.IsPositive() || !reserves.Quote.IsPositive() ||
+		!reserves.BaseWeight.IsPositive() || !reserves.QuoteWeight.IsPositive() {
+		return osmosisPoolReserves{}, fmt.Errorf("pool %d has no liquidity on Osmosis", poolID)
+	}
+
+	return reserves, nil
+}
+
+// getTwap queries the arithmetic TWAP of the base asset in the quote asset
+// since the given time.
+func (p OsmosisPoolProvider) getTwap(
+	poolID uint64,
+	base, quote OsmosisPoolAsset,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	query := url.Values{}
+	query.Set("pool_id", fmt.Sprintf("%d", poolID))
+	query.Set("base_asset", base.Denom)
+	query.Set("quote_asset", quote.Denom)
+	query.Set("start_time", startTime.UTC().Format(time.RFC3339))
+
+	var twapResp OsmosisPoolTwapResponse
+	if err := p.get(p.baseURL+osmosisPoolTwapEndpoint+"?"+query.Encode(), &twapResp); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	twap, err := sdk.NewDecFromStr(twapResp.ArithmeticTwap)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to parse Osmosis pool %d TWAP (%s)", poolID, twapResp.ArithmeticTwap)
+	}
+
+	return toDisplayPrice(twap, base.Exponent, quote.Exponent), nil
+}
+
+// get requests the given path and unmarshals the response body into resp.
+func (p OsmosisPoolProvider) get(path string, resp interface{}) error {
+	httpResp, err := p.client.Get(path)
+	if err != nil {
+		return fmt.Errorf("failed to make Osmosis request: %w", err)
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed Osmosis request with status %d", httpResp.StatusCode)
+	}
+
+	bz, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Osmosis response body: %w", err)
+	}
+
+	if err := json.Unmarshal(bz, resp); err != nil {
+		return fmt.Errorf("failed to unmarshal Osmosis response body: %w", err)
+	}
+
+	return nil
+}
+
+// spotPrice returns the price of the base asset in the quote asset of a
+// weighted pool, i.e. (quote / quoteWeight) / (base / baseWeight), multiplying
+// first to limit the loss of precision.
+func (r osmosisPoolReserves) spotPrice(base, quote OsmosisPoolAsset) sdk.Dec {
+	price := r.Quote.Mul(r.BaseWeight).Quo(r.Base.Mul(r.QuoteWeight))
+	return toDisplayPrice(price, base.Exponent, quote.Exponent)
+}
+
+// volume returns the volume reported for the prices of a pool, which is a share
+// of its liquidity expressed in display units of the base asset, like the
+// volumes of the other providers. The quote reserve of a weighted pool is worth
+// quoteWeight / baseWeight times its base reserve at the spot price.
+func (r osmosisPoolReserves) volume(base OsmosisPoolAsset) sdk.Dec {
+	liquidity := r.Base.Mul(r.BaseWeight.Add(r.QuoteWeight)).Quo(r.BaseWeight)
+	liquidity = liquidity.Quo(sdk.NewDec(10).Power(uint64(base.Exponent)))
+
+	return liquidity.Mul(osmosisPoolVolumeShare)
+}
+
+// osmosisPoolForPair returns the pool trading the given currency pair and its
+// base and quote assets.
+func osmosisPoolForPair(cp types.CurrencyPair) (uint64, OsmosisPoolAsset, OsmosisPoolAsset, error) {
+	baseSymbol, quoteSymbol := strings.ToUpper(cp.Base), strings.ToUpper(cp.Quote)
+
+	base, ok := osmosisPoolAssets[baseSymbol]
+	if !ok {
+		return 0, OsmosisPoolAsset{}, OsmosisPoolAsset{}, fmt.Errorf("unsupported Osmosis pool asset %s", baseSymbol)
+	}
+	quote, ok := osmosisPoolAssets[quoteSymbol]
+	if !ok {
+		return 0, OsmosisPoolAsset{}, OsmosisPoolAsset{}, fmt.Errorf("unsupported Osmosis pool asset %s", quoteSymbol)
+	}
+
+	if poolID, ok := osmosisPools[baseSymbol+quoteSymbol]; ok {
+		return poolID, base, quote, nil
+	}
+	if poolID, ok := osmosisPools[quoteSymbol+baseSymbol]; ok {
+		return poolID, base, quote, nil
+	}
+
+	return 0, OsmosisPoolAsset{}, OsmosisPoolAsset{}, fmt.Errorf("no Osmosis pool found for %s", cp.String())
+}
+
+// toDisplayPrice converts a price between base denoms into a price between
+// display denoms.
+func toDisplayPrice(price sdk.Dec, baseExponent, quoteExponent int64) sdk.Dec {
+	if baseExponent >= quoteExponent {
+		return price.Mul(sdk.NewDec(10).Power(uint64(baseExponent - quoteExponent)))
+	}
+
+	return price.Quo(sdk.NewDec(10).Power(uint64(quoteExponent - baseExponent)))
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+const (
+	osmosisPool678Response = `{
+  "pool": {
+    "@type": "/osmosis.gamm.v1beta1.Pool",
+    "address": "osmo1500hy75krs9e8t50aav6fahk8sxhajn9ctp40qwvvn8tcprkk6wszun4a5",
+    "id": "678",
+    "pool_params": {
+      "swap_fee": "0.002000000000000000",
+      "exit_fee": "0.000000000000000000",
+      "smooth_weight_change_params": null
+    },
+    "future_pool_governor": "24h",
+    "total_shares": {
+      "denom": "gamm/pool/678",
+      "amount": "1529839206373436598402218"
+    },
+    "pool_assets": [
+      {
+        "token": {
+          "denom": "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858",
+          "amount": "800000000000"
+        },
+        "weight": "536870912000000"
+      },
+      {
+        "token": {
+          "denom": "uosmo",
+          "amount": "1000000000000"
+        },
+        "weight": "536870912000000"
+      }
+    ],
+    "total_weight": "1073741824000000"
+  }
+}`
+
+	osmosisPool678TwapResponse = `{"arithmetic_twap":"0.801000000000000000"}`
+)
+
+func newTestOsmosisPoolServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case osmosisPoolEndpoint + "/678":
+			rw.Write([]byte(osmosisPool678Response))
+
+		case osmosisPoolTwapEndpoint:
+			require.Equal(t, "678", req.URL.Query().Get("pool_id"))
+			require.Equal(t, "uosmo", req.URL.Query().Get("base_asset"))
+			require.NotEmpty(t, req.URL.Query().Get("start_time"))
+			rw.Write([]byte(osmosisPool678TwapResponse))
+
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestOsmosisPoolProvider_GetTickerPrices(t *testing.T) {
+	server := newTestOsmosisPoolServer(t)
+	defer server.Close()
+
+	p := NewOsmosisPoolProvider(Endpoints{Rest: server.URL})
+
+	t.Run("valid_request_single_ticker", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "OSMO", Quote: "USDC"})
+		require.NoError(t, err)
+		require.Len(t, prices, 1)
+		require.Equal(t, sdk.MustNewDecFromStr("0.8"), prices["OSMOUSDC"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("20000"), prices["OSMOUSDC"].Volume)
+	})
+
+	t.Run("valid_request_reversed_pool", func(t *testing.T) {
+		prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "USDC", Quote: "OSMO"})
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("1.25"), prices["USDCOSMO"].Price)
+		require.Equal(t, sdk.MustNewDecFromStr("16000"), prices["USDCOSMO"].Volume)
+	})
+
+	t.Run("invalid_request_unknown_pool", func(t *testing.T) {
+		_, err := p.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "USDC"})
+		require.EqualError(t, err, "no Osmosis pool found for ATOMUSDC")
+
+		_, err = p.GetTickerPrices(types.CurrencyPair{Base: "FOO", Quote: "USDC"})
+		require.EqualError(t, err, "unsupported Osmosis pool asset FOO")
+	})
+
+	t.Run("invalid_request_failed_query", func(t *testing.T) {
+		_, err := p.GetTickerPrices(types.CurrencyPair{Base: "ATOM", Quote: "OSMO"})
+		require.EqualError(t, err, "failed Osmosis request with status 404")
+	})
+}
+
+func TestOsmosisPoolProvider_NoLiquidity(t *testing.T) {
+	drained := strings.Replace(osmosisPool678Response, `"amount": "800000000000"`, `"amount": "0"`, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(drained))
+	}))
+	defer server.Close()
+
+	p := NewOsmosisPoolProvider(Endpoints{Rest: server.URL})
+
+	_, err := p.GetTickerPrices(types.CurrencyPair{Base: "OSMO", Quote: "USDC"})
+	require.EqualError(t, err, "pool 678 has no liquidity on Osmosis")
+}
+
+func TestOsmosisPoolProvider_GetCandlePrices(t *testing.T) {
+	server := newTestOsmosisPoolServer(t)
+	defer server.Close()
+
+	p := NewOsmosisPoolProvider(Endpoints{Rest: server.URL})
+
+	candles, err := p.GetCandlePrices(types.CurrencyPair{Base: "OSMO", Quote: "USDC"})
+	require.NoError(t, err)
+	require.Len(t, candles["OSMOUSDC"], 1)
+	require.Equal(t, sdk.MustNewDecFromStr("0.801"), candles["OSMOUSDC"][0].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("20000"), candles["OSMOUSDC"][0].Volume)
+	require.Positive(t, candles["OSMOUSDC"][0].TimeStamp)
+}
+
+func TestToDisplayPrice(t *testing.T) {
+	price := sdk.MustNewDecFromStr("2.5")
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), toDisplayPrice(price, 6, 6))
+	require.Equal(t, sdk.MustNewDecFromStr("2500000000000"), toDisplayPrice(price, 18, 6))
+	require.Equal(t, sdk.MustNewDecFromStr("0.0000025"), toDisplayPrice(price, 6, 12))
+}
@@ -13,9 +13,12 @@ const (
 	defaultTimeout           = 10 * time.Second
 	defaultReadNewWSMessage  = 50 * time.Millisecond
 	defaultMaxConnectionTime = time.Hour * 23 // should be < 24h
-	defaultReconnectTime     = time.Minute * 20
 	maxReconnectionTries     = 3
-	providerCandlePeriod     = 10 * time.Minute
+	defaultReconnectTime     = time.Minute * 20
+
+	// CandlePeriod defines how long providers keep the candles they receive,
+	// which bounds the window over which the TVWAP can be computed.
+	CandlePeriod = 10 * time.Minute
 )
 
 var ping = []byte("ping")
@@ -30,8 +33,8 @@ type Provider interface {
 // TickerPrice defines price and volume information for a symbol or ticker
 // exchange rate.
 type TickerPrice struct {
-	Price  sdk.Dec // last trade price
-	Volume sdk.Dec // 24h volume
+	Price  sdk.Dec `json:"price"`  // last trade price
+	Volume sdk.Dec `json:"volume"` // 24h volume
 }
 
 // AggregatedProviderPrices defines a type alias for a map
@@ -41,9 +44,9 @@ type AggregatedProviderPrices map[string]map[string]TickerPrice
 // CandlePrice defines price, volume, and time information for an
 // exchange rate.
 type CandlePrice struct {
-	Price     sdk.Dec // last trade price
-	Volume    sdk.Dec // volume
-	TimeStamp int64   // timestamp
+	Price     sdk.Dec `json:"price"`     // last trade price
+	Volume    sdk.Dec `json:"volume"`    // volume
+	TimeStamp int64   `json:"timestamp"` // timestamp
 }
 
 // AggregatedProviderCandles defines a type alias for a map
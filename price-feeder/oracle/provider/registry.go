@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+const (
+	ProviderKraken      = "kraken"
+	ProviderBinance     = "binance"
+	ProviderOsmosis     = "osmosis"
+	ProviderOsmosisPool = "osmosispool"
+	ProviderHuobi       = "huobi"
+	ProviderOkx         = "okx"
+	ProviderGate        = "gate"
+	ProviderCoinbase    = "coinbase"
+	ProviderBitfinex    = "bitfinex"
+	ProviderMock        = "mock"
+)
+
+var (
+	registryMtx sync.RWMutex
+	registry    = make(map[string]Registration)
+)
+
+type (
+	// Factory defines a function that creates a Provider connected to the given
+	// endpoints and subscribed to the given currency pairs. The context is
+	// cancelled when the provider is no longer used, which should release any
+	// connection the provider holds.
+	Factory func(
+		ctx context.Context,
+		logger zerolog.Logger,
+		endpoints Endpoints,
+		pairs ...types.CurrencyPair,
+	) (Provider, error)
+
+	// Endpoints defines the REST and websocket URLs a provider connects to.
+	// Either may be empty when the provider does not use that transport, or
+	// when an override should fall back to the registered default.
+	Endpoints struct {
+		Rest      string
+		Websocket string
+	}
+
+	// Capabilities describes which data a provider can serve and any limits
+	// the price-feeder configuration must respect.
+	Capabilities struct {
+		Ticker    bool
+		Candles   bool
+		WebSocket bool
+
+		// MaxPairs limits the number of currency pairs the provider can be
+		// subscribed to. Zero means unlimited.
+		MaxPairs int
+	}
+
+	// Registration defines everything the price-feeder needs to know about a
+	// provider in order to validate a configuration and construct it.
+	Registration struct {
+		Name         string
+		Factory      Factory
+		Endpoints    Endpoints
+		Capabilities Capabilities
+	}
+)
+
+// Register makes a provider available by the given registration name. It is
+// meant to be called from an init function, so third party providers can be
+// compiled into the price-feeder by importing their package. Register panics
+// if the registration is incomplete or the name is already taken.
+func Register(r Registration) {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+
+	if len(r.Name) == 0 {
+		panic("provider: registration with an empty name")
+	}
+	if r.Factory == nil {
+		panic(fmt.Sprintf("provider: registration of %s without a factory", r.Name))
+	}
+	if _, ok := registry[r.Name]; ok {
+		panic(fmt.Sprintf("provider: %s registered twice", r.Name))
+	}
+
+	registry[r.Name] = r
+}
+
+// Lookup returns the registration of the provider with the given name.
+func Lookup(name string) (Registration, bool) {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+
+	r, ok := registry[name]
+	return r, ok
+}
+
+// Registered returns the sorted names of all registered providers.
+func Registered() []string {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// New creates the registered provider with the given name, subscribed to the
+// given currency pairs. Empty endpoints fall back to the registered defaults.
+func New(
+	ctx context.Context,
+	logger zerolog.Logger,
+	name string,
+	endpoints Endpoints,
+	pairs ...types.CurrencyPair,
+) (Provider, error) {
+	r, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported provider: %s", name)
+	}
+
+	return r.Factory(ctx, logger, endpoints.withDefaults(name), pairs...)
+}
+
+// withDefaults returns the endpoints with any empty URL replaced by the
+// default registered for the given provider.
+func (e Endpoints) withDefaults(name string) Endpoints {
+	r, _ := Lookup(name)
+
+	if len(e.Rest) == 0 {
+		e.Rest = r.Endpoints.Rest
+	}
+	if len(e.Websocket) == 0 {
+		e.Websocket = r.Endpoints.Websocket
+	}
+
+	return e
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+func TestRegistry(t *testing.T) {
+	for _, name := range []string{
+		ProviderKraken,
+		ProviderBinance,
+		ProviderOsmosis,
+		ProviderOsmosisPool,
+		ProviderHuobi,
+		ProviderOkx,
+		ProviderGate,
+		ProviderCoinbase,
+		ProviderBitfinex,
+		ProviderMock,
+	} {
+		r, ok := Lookup(name)
+		require.True(t, ok, name)
+		require.Equal(t, name, r.Name)
+		require.True(t, r.Capabilities.Ticker, name)
+		require.True(t, len(r.Endpoints.Rest) > 0 || len(r.Endpoints.Websocket) > 0, name)
+	}
+
+	_, ok := Lookup("foobar")
+	require.False(t, ok)
+
+	_, err := New(context.Background(), zerolog.Nop(), "foobar", Endpoints{})
+	require.EqualError(t, err, "unsupported provider: foobar")
+}
+
+func TestRegister(t *testing.T) {
+	const name = "test-provider"
+
+	factory := func(_ context.Context, _ zerolog.Logger, endpoints Endpoints, _ ...types.CurrencyPair) (Provider, error) {
+		return NewMockProvider(endpoints), nil
+	}
+	Register(Registration{Name: name, Factory: factory})
+	t.Cleanup(func() {
+		registryMtx.Lock()
+		delete(registry, name)
+		registryMtx.Unlock()
+	})
+
+	require.Contains(t, Registered(), name)
+
+	p, err := New(context.Background(), zerolog.Nop(), name, Endpoints{})
+	require.NoError(t, err)
+	require.IsType(t, &MockProvider{}, p)
+
+	require.Panics(t, func() { Register(Registration{Name: name, Factory: factory}) })
+	require.Panics(t, func() { Register(Registration{Factory: factory}) })
+	require.Panics(t, func() { Register(Registration{Name: "no-factory"}) })
+}
+
+func TestNew_EndpointOverride(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte("Base,Quote,Price,Volume\nUMEE,USDT,3.04,1827884.77\n"))
+	}))
+	defer server.Close()
+
+	p, err := New(context.Background(), zerolog.Nop(), ProviderMock, Endpoints{Rest: server.URL})
+	require.NoError(t, err)
+	require.Equal(t, server.URL, p.(*MockProvider).baseURL)
+
+	prices, err := p.GetTickerPrices(types.CurrencyPair{Base: "UMEE", Quote: "USDT"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("3.04"), prices["UMEEUSDT"].Price)
+
+	p, err = New(context.Background(), zerolog.Nop(), ProviderMock, Endpoints{})
+	require.NoError(t, err)
+	require.Equal(t, mockBaseURL, p.(*MockProvider).baseURL)
+}
+
+func TestBinanceProvider_EndpointOverride(t *testing.T) {
+	subscribed := make(chan BinanceSubscriptionMsg, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(rw, req, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		var msg BinanceSubscriptionMsg
+		require.NoError(t, conn.ReadJSON(&msg))
+		subscribed <- msg
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := NewBinanceProvider(
+		ctx,
+		zerolog.Nop(),
+		Endpoints{Websocket: "ws://" + server.Listener.Addr().String()},
+		types.CurrencyPair{Base: "ATOM", Quote: "USDT"},
+	)
+	require.NoError(t, err)
+
+	msg := <-subscribed
+	require.Contains(t, msg.Params, "atomusdt@ticker")
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
+)
+
+// loadFrames returns the frames recorded in testdata for the given provider.
+func loadFrames(t *testing.T, name string) []replay.Frame {
+	frames, err := replay.Load(filepath.Join("testdata", name+".jsonl"))
+	require.NoError(t, err)
+
+	return frames
+}
+
+// newReplayServer starts a replay server of the given frames, which is closed
+// at the end of the test, and returns the endpoints through which the provider
+// of the given name reaches it in place of the exchange.
+func newReplayServer(
+	t *testing.T,
+	name string,
+	frames []replay.Frame,
+	opts replay.Options,
+) (*replay.Server, Endpoints) {
+	server := replay.NewServer(frames, opts)
+	t.Cleanup(server.Close)
+
+	r, ok := Lookup(name)
+	require.True(t, ok)
+
+	rest, err := server.URL(r.Endpoints.Rest)
+	require.NoError(t, err)
+	websocket, err := server.URL(r.Endpoints.Websocket)
+	require.NoError(t, err)
+
+	return server, Endpoints{Rest: rest, Websocket: websocket}
+}
+
+// receivedMessages returns the messages the server received from the provider,
+// without the trailing newline of JSON encoded messages.
+func receivedMessages(server *replay.Server) []string {
+	received := server.Received()
+
+	msgs := make([]string, len(received))
+	for i, bz := range received {
+		msgs[i] = strings.TrimSpace(string(bz))
+	}
+
+	return msgs
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+)
+
+// wsConnection defines the websocket connection of a provider, which is
+// replaced on every reconnection. Websocket connections support a single
+// concurrent writer, while providers write from their message handling
+// goroutine (e.g. pings and resubscriptions) as well as from the callers of
+// SubscribeCurrencyPairs, so the connection is guarded by a mutex for every
+// write and replacement. Messages are read by a single goroutine without
+// holding the mutex, so that a blocking read does not delay writes.
+type wsConnection struct {
+	mtx  sync.Mutex
+	conn *websocket.Conn
+	done bool
+}
+
+func newWSConnection(conn *websocket.Conn) *wsConnection {
+	return &wsConnection{conn: conn}
+}
+
+// Set replaces the connection, e.g. once reconnected. The connection is closed
+// right away if the provider is already done.
+func (c *wsConnection) Set(conn *websocket.Conn) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.done {
+		_ = conn.Close()
+	}
+	c.conn = conn
+}
+
+// CloseOnDone closes the connection once ctx is canceled, from a separate
+// goroutine so that a read blocked on the connection is released.
+func (c *wsConnection) CloseOnDone(ctx context.Context, logger zerolog.Logger) {
+	go func() {
+		<-ctx.Done()
+
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+
+		c.done = true
+		if err := c.conn.Close(); err != nil {
+			logger.Err(err).Msg("failed to close websocket connection")
+		}
+	}()
+}
+
+// ReadMessage reads the next message of the current connection.
+func (c *wsConnection) ReadMessage() (int, []byte, error) {
+	c.mtx.Lock()
+	conn := c.conn
+	c.mtx.Unlock()
+
+	return conn.ReadMessage()
+}
+
+// WriteJSON writes the JSON encoding of v to the current connection.
+func (c *wsConnection) WriteJSON(v interface{}) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.conn.WriteJSON(v)
+}
+
+// WriteMessage writes a message of the given type to the current connection.
+func (c *wsConnection) WriteMessage(messageType int, data []byte) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.conn.WriteMessage(messageType, data)
+}
+
+// Close closes the current connection, which unblocks its pending read.
+func (c *wsConnection) Close() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.conn.Close()
+}
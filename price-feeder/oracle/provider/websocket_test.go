@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+	"github.com/umee-network/umee/price-feeder/pkg/replay"
+)
+
+// TestWSConnection_ConcurrentWrites subscribes to pairs, e.g. on a config
+// reload, while the message handling goroutine pings and reconnects. It is
+// meant to be run with the race detector.
+func TestWSConnection_ConcurrentWrites(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+
+	_, endpoints := newReplayServer(t, ProviderOkx, nil, replay.Options{})
+	p, err := NewOkxProvider(ctx, zerolog.Nop(), endpoints, pair)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			_ = p.ping()
+			_ = p.reconnect()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			// writes may fail on a connection closed by a reconnection
+			_ = p.SubscribeCurrencyPairs(pair)
+		}
+	}()
+
+	wg.Wait()
+
+	require.NoError(t, p.SubscribeCurrencyPairs(pair))
+}
+
+func TestWSConnection_CloseOnDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, endpoints := newReplayServer(t, ProviderBinance, nil, replay.Options{})
+	conn, _, err := websocket.DefaultDialer.Dial(endpoints.Websocket, nil)
+	require.NoError(t, err)
+
+	wsConn := newWSConnection(conn)
+	wsConn.CloseOnDone(ctx, zerolog.Nop())
+
+	readErr := make(chan error, 1)
+	go func() {
+		_, _, err := wsConn.ReadMessage()
+		readErr <- err
+	}()
+
+	// the blocked read is released once ctx is canceled
+	cancel()
+	select {
+	case err := <-readErr:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("read not released by the canceled context")
+	}
+
+	// connections set afterwards, e.g. by a pending reconnection, are closed
+	conn, _, err = websocket.DefaultDialer.Dial(endpoints.Websocket, nil)
+	require.NoError(t, err)
+	wsConn.Set(conn)
+
+	_, _, err = wsConn.ReadMessage()
+	require.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package oracle
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+)
+
+type (
+	// PriceReport defines the provider data from which the prices of the last
+	// SetPrices call were computed. Tickers and candles are converted to USD and
+	// keyed by provider and base, as the deviation filters see them.
+	PriceReport struct {
+		Timestamp time.Time                          `json:"timestamp"`
+		Tickers   provider.AggregatedProviderPrices  `json:"tickers"`
+		Candles   provider.AggregatedProviderCandles `json:"candles"`
+
+		// DeviatingTickers and DeviatingCandles reflect a mapping of
+		// provider => [<base>, ...] of the prices that were filtered out for
+		// deviating from the other providers.
+		DeviatingTickers map[string][]string `json:"deviating_tickers"`
+		DeviatingCandles map[string][]string `json:"deviating_candles"`
+
+		TVWAP  map[string]sdk.Dec `json:"tvwap"`
+		VWAP   map[string]sdk.Dec `json:"vwap"`
+		Prices map[string]sdk.Dec `json:"prices"`
+	}
+
+	// PriceSnapshot defines the prices of the last successful SetPrices call,
+	// along with the time and the block height they were computed at. The
+	// height is zero for prices set outside of an oracle tick.
+	PriceSnapshot struct {
+		Prices      map[string]sdk.Dec `json:"prices"`
+		Timestamp   time.Time          `json:"timestamp"`
+		BlockHeight int64              `json:"block_height"`
+	}
+
+	// VoteStatus defines the last pre-vote and vote broadcast by the oracle. In
+	// dry-run mode, they are only computed, and Tally compares the prices of the
+	// last vote with the exchange rates tallied on-chain.
+	VoteStatus struct {
+		DryRun  bool             `json:"dry_run"`
+		Prevote *TxResult        `json:"prevote"`
+		Vote    *TxResult        `json:"vote"`
+		Tally   *TallyComparison `json:"tally,omitempty"`
+	}
+
+	// TxResult defines the outcome of the broadcast of a pre-vote or vote. Hash
+	// is the aggregate vote hash committed to by the pre-vote, or revealed by the
+	// vote. BlockHeight is the height at which the broadcast started, and the
+	// tx fields are only set once the transaction reached the node.
+	TxResult struct {
+		Hash          string    `json:"hash"`
+		ExchangeRates string    `json:"exchange_rates"`
+		BlockHeight   int64     `json:"block_height"`
+		TxHash        string    `json:"tx_hash,omitempty"`
+		TxHeight      int64     `json:"tx_height,omitempty"`
+		TxCode        uint32    `json:"tx_code"`
+		Error         string    `json:"error,omitempty"`
+		Timestamp     time.Time `json:"timestamp"`
+	}
+)
+
+// newTxResult returns the TxResult of a broadcast that started at the given
+// height, and ended with the given response and error.
+func newTxResult(
+	hash, exchangeRates string,
+	blockHeight int64,
+	resp *sdk.TxResponse,
+	err error,
+) *TxResult {
+	result := &TxResult{
+		Hash:          hash,
+		ExchangeRates: exchangeRates,
+		BlockHeight:   blockHeight,
+		Timestamp:     time.Now(),
+	}
+
+	if resp != nil {
+		result.TxHash = resp.TxHash
+		result.TxHeight = resp.Height
+		result.TxCode = resp.Code
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// GetPriceReport returns the provider data from which the prices of the last
+// SetPrices call were computed.
+func (o *Oracle) GetPriceReport() PriceReport {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.priceReport
+}
+
+// GetVoteStatus returns the last pre-vote and vote broadcast by the oracle.
+func (o *Oracle) GetVoteStatus() VoteStatus {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	return o.voteStatus
+}
+
+// setPrevoteResult records the outcome of the last pre-vote broadcast.
+func (o *Oracle) setPrevoteResult(result *TxResult) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.voteStatus.Prevote = result
+}
+
+// setVoteResult records the outcome of the last vote broadcast.
+func (o *Oracle) setVoteResult(result *TxResult) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.voteStatus.Vote = result
+}
+
+// deviatingTickers returns the bases of the tickers of every provider that are
+// missing from the filtered tickers.
+func deviatingTickers(
+	tickers, filtered provider.AggregatedProviderPrices,
+) map[string][]string {
+	deviating := make(map[string][]string)
+	for providerName, prices := range tickers {
+		for base := range prices {
+			if _, ok := filtered[providerName][base]; !ok {
+				deviating[providerName] = append(deviating[providerName], base)
+			}
+		}
+		sort.Strings(deviating[providerName])
+	}
+
+	return deviating
+}
+
+// deviatingCandles returns the bases of the candles of every provider that are
+// missing from the filtered candles.
+func deviatingCandles(
+	candles, filtered provider.AggregatedProviderCandles,
+) map[string][]string {
+	deviating := make(map[string][]string)
+	for providerName, prices := range candles {
+		for base := range prices {
+			if _, ok := filtered[providerName][base]; !ok {
+				deviating[providerName] = append(deviating[providerName], base)
+			}
+		}
+		sort.Strings(deviating[providerName])
+	}
+
+	return deviating
+}
@@ -0,0 +1,73 @@
+package oracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// LoadPreviousPrevote reads the previous prevote persisted to the given state
+// file. It returns nil if there is no state file.
+func LoadPreviousPrevote(stateFile string) (*PreviousPrevote, error) {
+	bz, err := ioutil.ReadFile(stateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prevote state: %w", err)
+	}
+
+	var prevote PreviousPrevote
+	if err := json.Unmarshal(bz, &prevote); err != nil {
+		return nil, fmt.Errorf("failed to decode prevote state: %w", err)
+	}
+
+	return &prevote, nil
+}
+
+// SavePreviousPrevote persists the previous prevote to the given state file,
+// or removes the state file if there is no previous prevote. The file is
+// replaced atomically, so that a crash never leaves a partially written salt
+// behind.
+func SavePreviousPrevote(stateFile string, prevote *PreviousPrevote) error {
+	if prevote == nil {
+		if err := os.Remove(stateFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove prevote state: %w", err)
+		}
+		return nil
+	}
+
+	bz, err := json.Marshal(prevote)
+	if err != nil {
+		return fmt.Errorf("failed to encode prevote state: %w", err)
+	}
+
+	// the temporary file is only readable by the owner, as is the state file it
+	// replaces
+	tmpFile, err := ioutil.TempFile(filepath.Dir(stateFile), filepath.Base(stateFile)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create prevote state: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(bz); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write prevote state: %w", err)
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write prevote state: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("failed to write prevote state: %w", err)
+	}
+
+	if err := os.Rename(tmpFile.Name(), stateFile); err != nil {
+		return fmt.Errorf("failed to replace prevote state: %w", err)
+	}
+
+	return nil
+}
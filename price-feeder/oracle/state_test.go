@@ -0,0 +1,183 @@
+package oracle
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/umee-network/umee/price-feeder/oracle/client"
+	oracletypes "github.com/umee-network/umee/x/oracle/types"
+)
+
+// prevoteQueryServer defines an x/oracle query server failing every aggregate
+// prevote query with err.
+type prevoteQueryServer struct {
+	oracletypes.UnimplementedQueryServer
+
+	err error
+}
+
+func (s *prevoteQueryServer) AggregatePrevote(
+	context.Context,
+	*oracletypes.QueryAggregatePrevoteRequest,
+) (*oracletypes.QueryAggregatePrevoteResponse, error) {
+	return nil, s.err
+}
+
+// newPrevoteOracle returns an oracle restoring a persisted prevote, which
+// queries the on-chain prevote from a gRPC server failing with err, or from an
+// unreachable node if err is nil.
+func newPrevoteOracle(t *testing.T, err error) (*Oracle, string) {
+	listener, lerr := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, lerr)
+
+	grpcEndpoint := listener.Addr().String()
+	if err == nil {
+		listener.Close()
+	} else {
+		server := grpc.NewServer()
+		oracletypes.RegisterQueryServer(server, &prevoteQueryServer{err: err})
+		go func() { _ = server.Serve(listener) }()
+		t.Cleanup(server.Stop)
+	}
+
+	nodes, nerr := client.NewNodePool(
+		context.Background(),
+		zerolog.Nop(),
+		[]client.NodeEndpoints{{TMRPC: "http://127.0.0.1:1", GRPC: grpcEndpoint}},
+		100*time.Millisecond,
+	)
+	require.NoError(t, nerr)
+
+	stateFile := filepath.Join(t.TempDir(), "prevote.json")
+	require.NoError(t, SavePreviousPrevote(stateFile, &PreviousPrevote{
+		ExchangeRates:     "ATOM:11.1",
+		Salt:              "salt",
+		SubmitBlockHeight: 10,
+	}))
+
+	oracleClient := client.OracleClient{
+		Nodes:               nodes,
+		ValidatorAddrString: sdk.ValAddress(make([]byte, 20)).String(),
+	}
+
+	return New(
+		zerolog.Nop(),
+		oracleClient,
+		Options{
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+			StateFile:    stateFile,
+		},
+	), stateFile
+}
+
+func TestSaveAndLoadPreviousPrevote(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "prevote.json")
+
+	// no state file
+	prevote, err := LoadPreviousPrevote(stateFile)
+	require.NoError(t, err)
+	require.Nil(t, prevote)
+
+	expected := &PreviousPrevote{
+		ExchangeRates:     "ATOM:11.1,UMEE:0.1",
+		Salt:              "salt",
+		SubmitBlockHeight: 10,
+	}
+	require.NoError(t, SavePreviousPrevote(stateFile, expected))
+
+	prevote, err = LoadPreviousPrevote(stateFile)
+	require.NoError(t, err)
+	require.Equal(t, expected, prevote)
+
+	info, err := os.Stat(stateFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Dir(stateFile))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// the state file is removed once there is no pending prevote
+	require.NoError(t, SavePreviousPrevote(stateFile, nil))
+	_, err = os.Stat(stateFile)
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, SavePreviousPrevote(stateFile, nil))
+
+	// a corrupted state file is rejected
+	require.NoError(t, os.WriteFile(stateFile, []byte("{"), 0600))
+	_, err = LoadPreviousPrevote(stateFile)
+	require.Error(t, err)
+}
+
+func TestRestorePreviousPrevote_Expired(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "prevote.json")
+	require.NoError(t, SavePreviousPrevote(stateFile, &PreviousPrevote{
+		ExchangeRates:     "ATOM:11.1",
+		Salt:              "salt",
+		SubmitBlockHeight: 10,
+	}))
+
+	oracle := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		Options{
+			TVWAPWindow:  testTVWAPWindow,
+			MaxCandleAge: testMaxCandleAge,
+			StateFile:    stateFile,
+		},
+	)
+	require.False(t, oracle.prevoteRestored)
+
+	// the prevote was submitted in vote period 2, so it cannot be revealed in 4
+	require.NoError(t, oracle.restorePreviousPrevote(5, 4))
+	require.True(t, oracle.prevoteRestored)
+	require.Nil(t, oracle.previousPrevote)
+
+	_, err := os.Stat(stateFile)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestRestorePreviousPrevote_NoPrevote(t *testing.T) {
+	for name, err := range map[string]error{
+		"not found":            status.Error(codes.NotFound, "not found"),
+		"no aggregate prevote": sdkerrors.Wrap(oracletypes.ErrNoAggregatePrevote, "validator"),
+		"invalid request":      status.Error(codes.InvalidArgument, "invalid validator"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			oracle, stateFile := newPrevoteOracle(t, err)
+
+			// the prevote is discarded and the tick goes on
+			require.NoError(t, oracle.restorePreviousPrevote(5, 2))
+			require.True(t, oracle.prevoteRestored)
+			require.Nil(t, oracle.previousPrevote)
+
+			_, err := os.Stat(stateFile)
+			require.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestRestorePreviousPrevote_Unavailable(t *testing.T) {
+	oracle, stateFile := newPrevoteOracle(t, nil)
+
+	// the restore is retried on the next tick
+	require.Error(t, oracle.restorePreviousPrevote(5, 2))
+	require.False(t, oracle.prevoteRestored)
+
+	_, err := os.Stat(stateFile)
+	require.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DenomUSD defines the quote all exchange rates are eventually converted to.
+const DenomUSD = "USD"
+
+// ConversionOrder returns the upper cased bases of the given currency pairs,
+// ordered so that every base comes after all the quotes it is priced in. Rates
+// computed in that order can be converted to USD through the rates of their
+// quotes, e.g. ATOM/USDT through USDT/USD. It returns an error if a quote is
+// not the base of any pair or if quotes depend on each other in a cycle.
+func ConversionOrder(pairs []CurrencyPair) ([]string, error) {
+	quotes := make(map[string][]string)
+	for _, cp := range pairs {
+		base := strings.ToUpper(cp.Base)
+		quotes[base] = append(quotes[base], strings.ToUpper(cp.Quote))
+	}
+
+	bases := make([]string, 0, len(quotes))
+	for base := range quotes {
+		bases = append(bases, base)
+	}
+	sort.Strings(bases)
+
+	var (
+		order    = make([]string, 0, len(bases))
+		visited  = make(map[string]bool)
+		visiting = make(map[string]bool)
+		visit    func(denom string, path []string) error
+	)
+
+	visit = func(denom string, path []string) error {
+		path = append(path, denom)
+
+		switch {
+		case denom == DenomUSD || visited[denom]:
+			return nil
+		case visiting[denom]:
+			return fmt.Errorf("cyclic conversion path to %s: %s", DenomUSD, strings.Join(path, " -> "))
+		}
+
+		denomQuotes, ok := quotes[denom]
+		if !ok {
+			return fmt.Errorf(
+				"unable to convert %s to %s: no currency pair with base %s", path[0], DenomUSD, denom,
+			)
+		}
+
+		visiting[denom] = true
+		for _, quote := range denomQuotes {
+			if err := visit(quote, path); err != nil {
+				return err
+			}
+		}
+		visiting[denom] = false
+		visited[denom] = true
+
+		order = append(order, denom)
+		return nil
+	}
+
+	for _, base := range bases {
+		if err := visit(base, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// ConversionBases returns the upper cased bases whose rates are needed to
+// convert the rates of the given bases to USD, including the given bases.
+func ConversionBases(pairs []CurrencyPair, bases ...string) map[string]struct{} {
+	quotes := make(map[string][]string)
+	for _, cp := range pairs {
+		base := strings.ToUpper(cp.Base)
+		quotes[base] = append(quotes[base], strings.ToUpper(cp.Quote))
+	}
+
+	needed := make(map[string]struct{})
+	pending := make([]string, 0, len(bases))
+	for _, base := range bases {
+		pending = append(pending, strings.ToUpper(base))
+	}
+
+	for len(pending) > 0 {
+		denom := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if _, ok := needed[denom]; ok || denom == DenomUSD {
+			continue
+		}
+
+		needed[denom] = struct{}{}
+		pending = append(pending, quotes[denom]...)
+	}
+
+	return needed
+}
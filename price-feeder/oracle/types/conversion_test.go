@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/oracle/types"
+)
+
+func TestConversionOrder(t *testing.T) {
+	order, err := types.ConversionOrder([]types.CurrencyPair{
+		{Base: "STATOM", Quote: "ATOM"},
+		{Base: "ATOM", Quote: "USDT"},
+		{Base: "atom", Quote: "usd"},
+		{Base: "USDT", Quote: "USD"},
+		{Base: "UMEE", Quote: "USD"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"USDT", "ATOM", "STATOM", "UMEE"}, order)
+
+	_, err = types.ConversionOrder([]types.CurrencyPair{
+		{Base: "ATOM", Quote: "USDT"},
+	})
+	require.EqualError(t, err, "unable to convert ATOM to USD: no currency pair with base USDT")
+
+	_, err = types.ConversionOrder([]types.CurrencyPair{
+		{Base: "ATOM", Quote: "OSMO"},
+		{Base: "OSMO", Quote: "ATOM"},
+		{Base: "OSMO", Quote: "USD"},
+	})
+	require.EqualError(t, err, "cyclic conversion path to USD: ATOM -> OSMO -> ATOM")
+}
+
+func TestConversionBases(t *testing.T) {
+	pairs := []types.CurrencyPair{
+		{Base: "STATOM", Quote: "ATOM"},
+		{Base: "ATOM", Quote: "USDT"},
+		{Base: "USDT", Quote: "USD"},
+		{Base: "UMEE", Quote: "USDC"},
+		{Base: "USDC", Quote: "USD"},
+	}
+
+	require.Equal(
+		t,
+		map[string]struct{}{"STATOM": {}, "ATOM": {}, "USDT": {}},
+		types.ConversionBases(pairs, "statom"),
+	)
+	require.Equal(
+		t,
+		map[string]struct{}{"UMEE": {}, "USDC": {}},
+		types.ConversionBases(pairs, "UMEE"),
+	)
+}
@@ -13,11 +13,6 @@ var (
 	minimumTimeWeight = sdk.MustNewDecFromStr("0.2")
 )
 
-const (
-	// tvwapCandlePeriod represents the time period we use for tvwap in minutes
-	tvwapCandlePeriod = 3 * time.Minute
-)
-
 // compute VWAP for each base by dividing the Σ {P * V} by Σ {V}
 func vwap(weightedPrices map[string]sdk.Dec, volumeSum map[string]sdk.Dec) (map[string]sdk.Dec, error) {
 	vwap := make(map[string]sdk.Dec)
@@ -68,16 +63,16 @@ func ComputeVWAP(prices provider.AggregatedProviderPrices) (map[string]sdk.Dec,
 
 // ComputeTVWAP computes the time volume weighted average price for all points
 // for each exchange pair. Filters out any candles that did not occur within
-// timePeriod. The provided prices argument reflects a mapping of
+// the given window. The provided prices argument reflects a mapping of
 // provider => {<base> => <TickerPrice>, ...}.
 //
 // Ref : https://en.wikipedia.org/wiki/Time-weighted_average_price
-func ComputeTVWAP(prices provider.AggregatedProviderCandles) (map[string]sdk.Dec, error) {
+func ComputeTVWAP(prices provider.AggregatedProviderCandles, window time.Duration) (map[string]sdk.Dec, error) {
 	var (
 		weightedPrices = make(map[string]sdk.Dec)
 		volumeSum      = make(map[string]sdk.Dec)
 		now            = provider.PastUnixTime(0)
-		timePeriod     = provider.PastUnixTime(tvwapCandlePeriod)
+		timePeriod     = provider.PastUnixTime(window)
 	)
 
 	for _, providerPrices := range prices {
@@ -103,7 +98,7 @@ func ComputeTVWAP(prices provider.AggregatedProviderCandles) (map[string]sdk.Dec
 
 			// get weighted prices, and sum of volumes
 			for _, candle := range cp {
-				// we only want candles within the last timePeriod
+				// we only want candles within the window
 				if timePeriod < candle.TimeStamp {
 					// timeDiff = now - candle.TimeStamp
 					timeDiff := sdk.NewDec(now - candle.TimeStamp)
@@ -181,3 +176,52 @@ func StandardDeviation(
 
 	return deviations, means, nil
 }
+
+// MedianDeviation returns maps of the median absolute deviations and medians of
+// assets.
+//
+// Ref: https://en.wikipedia.org/wiki/Median_absolute_deviation
+func MedianDeviation(
+	prices map[string]map[string]sdk.Dec,
+) (map[string]sdk.Dec, map[string]sdk.Dec) {
+	var (
+		deviations = make(map[string]sdk.Dec)
+		medians    = make(map[string]sdk.Dec)
+		priceSlice = make(map[string][]sdk.Dec)
+	)
+
+	for _, providerPrices := range prices {
+		for base, p := range providerPrices {
+			priceSlice[base] = append(priceSlice[base], p)
+		}
+	}
+
+	for base, prices := range priceSlice {
+		medians[base] = median(prices)
+
+		absDeviations := make([]sdk.Dec, len(prices))
+		for i, price := range prices {
+			absDeviations[i] = price.Sub(medians[base]).Abs()
+		}
+
+		deviations[base] = median(absDeviations)
+	}
+
+	return deviations, medians
+}
+
+// median returns the median of the given non-empty prices.
+func median(prices []sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
@@ -2,10 +2,10 @@ package oracle_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
-	"github.com/umee-network/umee/price-feeder/config"
 	"github.com/umee-network/umee/price-feeder/oracle"
 	"github.com/umee-network/umee/price-feeder/oracle/provider"
 )
@@ -25,7 +25,7 @@ func TestComputeVWAP(t *testing.T) {
 		},
 		"non empty prices": {
 			prices: map[string]map[string]provider.TickerPrice{
-				config.ProviderBinance: {
+				provider.ProviderBinance: {
 					"ATOM": provider.TickerPrice{
 						Price:  sdk.MustNewDecFromStr("28.21000000"),
 						Volume: sdk.MustNewDecFromStr("2749102.78000000"),
@@ -39,7 +39,7 @@ func TestComputeVWAP(t *testing.T) {
 						Volume: sdk.MustNewDecFromStr("7854934.69000000"),
 					},
 				},
-				config.ProviderKraken: {
+				provider.ProviderKraken: {
 					"ATOM": provider.TickerPrice{
 						Price:  sdk.MustNewDecFromStr("28.268700"),
 						Volume: sdk.MustNewDecFromStr("178277.53314385"),
@@ -79,6 +79,41 @@ func TestComputeVWAP(t *testing.T) {
 	}
 }
 
+func TestComputeTVWAP_Window(t *testing.T) {
+	prices := provider.AggregatedProviderCandles{
+		provider.ProviderBinance: {
+			"ATOM": []provider.CandlePrice{
+				{
+					Price:     sdk.MustNewDecFromStr("10"),
+					Volume:    sdk.MustNewDecFromStr("100"),
+					TimeStamp: provider.PastUnixTime(5 * time.Minute),
+				},
+				{
+					Price:     sdk.MustNewDecFromStr("12"),
+					Volume:    sdk.MustNewDecFromStr("100"),
+					TimeStamp: provider.PastUnixTime(1 * time.Minute),
+				},
+			},
+		},
+	}
+
+	// only the latest candle is within the window
+	tvwap, err := oracle.ComputeTVWAP(prices, 3*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("12"), tvwap["ATOM"])
+
+	// the older candle is weighted less than the latest one
+	tvwap, err = oracle.ComputeTVWAP(prices, 10*time.Minute)
+	require.NoError(t, err)
+	require.True(t, tvwap["ATOM"].GT(sdk.MustNewDecFromStr("11")))
+	require.True(t, tvwap["ATOM"].LT(sdk.MustNewDecFromStr("12")))
+
+	// no candle is within the window
+	tvwap, err = oracle.ComputeTVWAP(prices, 30*time.Second)
+	require.NoError(t, err)
+	require.Empty(t, tvwap)
+}
+
 func TestStandardDeviation(t *testing.T) {
 	type deviation struct {
 		mean      sdk.Dec
@@ -98,12 +133,12 @@ func TestStandardDeviation(t *testing.T) {
 		},
 		"not enough prices": {
 			prices: map[string]map[string]sdk.Dec{
-				config.ProviderBinance: {
+				provider.ProviderBinance: {
 					"ATOM": sdk.MustNewDecFromStr("28.21000000"),
 					"UMEE": sdk.MustNewDecFromStr("1.13000000"),
 					"LUNA": sdk.MustNewDecFromStr("64.87000000"),
 				},
-				config.ProviderKraken: {
+				provider.ProviderKraken: {
 					"ATOM": sdk.MustNewDecFromStr("28.23000000"),
 					"UMEE": sdk.MustNewDecFromStr("1.13050000"),
 					"LUNA": sdk.MustNewDecFromStr("64.85000000"),
@@ -113,16 +148,16 @@ func TestStandardDeviation(t *testing.T) {
 		},
 		"some prices": {
 			prices: map[string]map[string]sdk.Dec{
-				config.ProviderBinance: {
+				provider.ProviderBinance: {
 					"ATOM": sdk.MustNewDecFromStr("28.21000000"),
 					"UMEE": sdk.MustNewDecFromStr("1.13000000"),
 					"LUNA": sdk.MustNewDecFromStr("64.87000000"),
 				},
-				config.ProviderKraken: {
+				provider.ProviderKraken: {
 					"ATOM": sdk.MustNewDecFromStr("28.23000000"),
 					"UMEE": sdk.MustNewDecFromStr("1.13050000"),
 				},
-				config.ProviderOsmosis: {
+				provider.ProviderOsmosis: {
 					"ATOM": sdk.MustNewDecFromStr("28.40000000"),
 					"UMEE": sdk.MustNewDecFromStr("1.14000000"),
 					"LUNA": sdk.MustNewDecFromStr("64.10000000"),
@@ -142,18 +177,18 @@ func TestStandardDeviation(t *testing.T) {
 
 		"non empty prices": {
 			prices: map[string]map[string]sdk.Dec{
-				config.ProviderBinance: {
+				provider.ProviderBinance: {
 					"ATOM": sdk.MustNewDecFromStr("28.21000000"),
 
 					"UMEE": sdk.MustNewDecFromStr("1.13000000"),
 					"LUNA": sdk.MustNewDecFromStr("64.87000000"),
 				},
-				config.ProviderKraken: {
+				provider.ProviderKraken: {
 					"ATOM": sdk.MustNewDecFromStr("28.23000000"),
 					"UMEE": sdk.MustNewDecFromStr("1.13050000"),
 					"LUNA": sdk.MustNewDecFromStr("64.85000000"),
 				},
-				config.ProviderOsmosis: {
+				provider.ProviderOsmosis: {
 					"ATOM": sdk.MustNewDecFromStr("28.40000000"),
 					"UMEE": sdk.MustNewDecFromStr("1.14000000"),
 					"LUNA": sdk.MustNewDecFromStr("64.10000000"),
@@ -192,3 +227,69 @@ func TestStandardDeviation(t *testing.T) {
 		})
 	}
 }
+
+func TestMedianDeviation(t *testing.T) {
+	type deviation struct {
+		median    sdk.Dec
+		deviation sdk.Dec
+	}
+	testCases := map[string]struct {
+		prices   map[string]map[string]sdk.Dec
+		expected map[string]deviation
+	}{
+		"nil prices": {
+			prices:   nil,
+			expected: map[string]deviation{},
+		},
+		"odd number of prices": {
+			prices: map[string]map[string]sdk.Dec{
+				provider.ProviderBinance: {
+					"ATOM": sdk.MustNewDecFromStr("28.21000000"),
+				},
+				provider.ProviderKraken: {
+					"ATOM": sdk.MustNewDecFromStr("28.23000000"),
+				},
+				provider.ProviderOsmosis: {
+					"ATOM": sdk.MustNewDecFromStr("31.40000000"),
+				},
+			},
+			expected: map[string]deviation{
+				"ATOM": {
+					median:    sdk.MustNewDecFromStr("28.23"),
+					deviation: sdk.MustNewDecFromStr("0.02"),
+				},
+			},
+		},
+		"even number of prices": {
+			prices: map[string]map[string]sdk.Dec{
+				provider.ProviderBinance: {
+					"UMEE": sdk.MustNewDecFromStr("1.13000000"),
+				},
+				provider.ProviderKraken: {
+					"UMEE": sdk.MustNewDecFromStr("1.14000000"),
+				},
+			},
+			expected: map[string]deviation{
+				"UMEE": {
+					median:    sdk.MustNewDecFromStr("1.135"),
+					deviation: sdk.MustNewDecFromStr("0.005"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			deviation, median := oracle.MedianDeviation(tc.prices)
+			require.Len(t, deviation, len(tc.expected))
+			require.Len(t, median, len(tc.expected))
+
+			for k, v := range tc.expected {
+				require.Equalf(t, v.deviation, deviation[k], "unexpected deviation for %s", k)
+				require.Equalf(t, v.median, median[k], "unexpected median for %s", k)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package attestation
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// ErrInvalidSignature defines a sentinel error for an attestation whose
+	// signature does not match its content.
+	ErrInvalidSignature = errors.New("invalid attestation signature")
+
+	// ErrUnexpectedSigner defines a sentinel error for an attestation signed
+	// for another chain, validator or key than expected.
+	ErrUnexpectedSigner = errors.New("unexpected attestation signer")
+)
+
+type (
+	// Price defines the exchange rate of a denom in USD.
+	Price struct {
+		Denom string  `json:"denom"`
+		Price sdk.Dec `json:"price"`
+	}
+
+	// Attestation defines the prices computed by the price-feeder of a
+	// validator at a given time and block height, signed by the attestation key
+	// of the validator. PubKey is the compressed secp256k1 attestation key.
+	Attestation struct {
+		ChainID     string    `json:"chain_id"`
+		Validator   string    `json:"validator"`
+		BlockHeight int64     `json:"block_height"`
+		Timestamp   time.Time `json:"timestamp"`
+		Prices      []Price   `json:"prices"`
+		PubKey      []byte    `json:"pub_key"`
+		Signature   []byte    `json:"signature"`
+	}
+
+	// signDoc defines the content of an attestation that is signed.
+	signDoc struct {
+		ChainID     string    `json:"chain_id"`
+		Validator   string    `json:"validator"`
+		BlockHeight int64     `json:"block_height"`
+		Timestamp   time.Time `json:"timestamp"`
+		Prices      []Price   `json:"prices"`
+	}
+
+	// Signer signs the prices of a validator with its attestation key.
+	Signer struct {
+		privKey   cryptotypes.PrivKey
+		chainID   string
+		validator string
+	}
+)
+
+// NewSigner returns a Signer attesting the prices computed for the given
+// validator on the given chain with privKey.
+func NewSigner(privKey cryptotypes.PrivKey, chainID, validator string) *Signer {
+	return &Signer{
+		privKey:   privKey,
+		chainID:   chainID,
+		validator: validator,
+	}
+}
+
+// LoadPrivKey reads a hex encoded secp256k1 private key from the given file.
+func LoadPrivKey(path string) (cryptotypes.PrivKey, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attestation key: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil || len(key) != secp256k1.PrivKeySize {
+		return nil, fmt.Errorf("invalid attestation key: expected %d hex encoded bytes", secp256k1.PrivKeySize)
+	}
+
+	return &secp256k1.PrivKey{Key: key}, nil
+}
+
+// PubKey returns the public attestation key of the signer.
+func (s *Signer) PubKey() cryptotypes.PubKey {
+	return s.privKey.PubKey()
+}
+
+// Sign returns an attestation of the given prices, computed at the given time
+// and block height.
+func (s *Signer) Sign(prices map[string]sdk.Dec, timestamp time.Time, blockHeight int64) (Attestation, error) {
+	a := Attestation{
+		ChainID:     s.chainID,
+		Validator:   s.validator,
+		BlockHeight: blockHeight,
+		Timestamp:   timestamp.UTC(),
+		Prices:      make([]Price, 0, len(prices)),
+		PubKey:      s.PubKey().Bytes(),
+	}
+
+	for denom, price := range prices {
+		a.Prices = append(a.Prices, Price{Denom: denom, Price: price})
+	}
+	sort.Slice(a.Prices, func(i, j int) bool {
+		return a.Prices[i].Denom < a.Prices[j].Denom
+	})
+
+	bz, err := a.SignBytes()
+	if err != nil {
+		return Attestation{}, err
+	}
+
+	a.Signature, err = s.privKey.Sign(bz)
+	if err != nil {
+		return Attestation{}, fmt.Errorf("failed to sign attestation: %w", err)
+	}
+
+	return a, nil
+}
+
+// SignBytes returns the canonical bytes of the attestation that are signed,
+// i.e. its JSON encoding without the public key and signature.
+func (a Attestation) SignBytes() ([]byte, error) {
+	return json.Marshal(signDoc{
+		ChainID:     a.ChainID,
+		Validator:   a.Validator,
+		BlockHeight: a.BlockHeight,
+		Timestamp:   a.Timestamp,
+		Prices:      a.Prices,
+	})
+}
+
+// PriceMap returns the attested prices keyed by denom.
+func (a Attestation) PriceMap() map[string]sdk.Dec {
+	prices := make(map[string]sdk.Dec, len(a.Prices))
+	for _, p := range a.Prices {
+		prices[p.Denom] = p.Price
+	}
+
+	return prices
+}
+
+// Verify returns an error unless the attestation was signed with pubKey for the
+// given validator on the given chain. The attestation key of a validator is not
+// stored on-chain, so it must be obtained from the validator beforehand.
+func Verify(a Attestation, chainID, validator string, pubKey cryptotypes.PubKey) error {
+	if a.ChainID != chainID || a.Validator != validator {
+		return fmt.Errorf("%w: attested by %s on %s", ErrUnexpectedSigner, a.Validator, a.ChainID)
+	}
+	if !pubKey.Equals(&secp256k1.PubKey{Key: a.PubKey}) {
+		return fmt.Errorf("%w: unexpected public key", ErrUnexpectedSigner)
+	}
+
+	bz, err := a.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(bz, a.Signature) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
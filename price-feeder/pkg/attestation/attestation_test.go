@@ -0,0 +1,105 @@
+package attestation_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/umee-network/umee/price-feeder/pkg/attestation"
+)
+
+const (
+	chainID   = "umee-local-testnet"
+	validator = "umeevaloper12tysz6mzrawenca2t3t7ltym4hfjj8a5upsn2k"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	signer := attestation.NewSigner(privKey, chainID, validator)
+
+	prices := map[string]sdk.Dec{
+		"UMEE": sdk.MustNewDecFromStr("4.21"),
+		"ATOM": sdk.MustNewDecFromStr("34.84"),
+	}
+	timestamp := time.Now()
+
+	a, err := signer.Sign(prices, timestamp, 1234)
+	require.NoError(t, err)
+	require.Equal(t, "ATOM", a.Prices[0].Denom)
+	require.Equal(t, prices, a.PriceMap())
+	require.Equal(t, int64(1234), a.BlockHeight)
+	require.True(t, timestamp.Equal(a.Timestamp))
+	require.NoError(t, attestation.Verify(a, chainID, validator, privKey.PubKey()))
+
+	// attestations are verified as served
+	bz, err := json.Marshal(a)
+	require.NoError(t, err)
+	var served attestation.Attestation
+	require.NoError(t, json.Unmarshal(bz, &served))
+	require.NoError(t, attestation.Verify(served, chainID, validator, privKey.PubKey()))
+
+	tampered := served
+	tampered.Prices = []attestation.Price{
+		{Denom: "ATOM", Price: sdk.MustNewDecFromStr("1")},
+		{Denom: "UMEE", Price: sdk.MustNewDecFromStr("4.21")},
+	}
+	err = attestation.Verify(tampered, chainID, validator, privKey.PubKey())
+	require.True(t, errors.Is(err, attestation.ErrInvalidSignature))
+
+	tampered = served
+	tampered.BlockHeight++
+	err = attestation.Verify(tampered, chainID, validator, privKey.PubKey())
+	require.True(t, errors.Is(err, attestation.ErrInvalidSignature))
+
+	err = attestation.Verify(served, "umee-1", validator, privKey.PubKey())
+	require.True(t, errors.Is(err, attestation.ErrUnexpectedSigner))
+
+	err = attestation.Verify(served, chainID, "umeevaloper1", privKey.PubKey())
+	require.True(t, errors.Is(err, attestation.ErrUnexpectedSigner))
+
+	err = attestation.Verify(served, chainID, validator, secp256k1.GenPrivKey().PubKey())
+	require.True(t, errors.Is(err, attestation.ErrUnexpectedSigner))
+
+	// the public key of the attestation cannot be swapped for another key
+	otherKey := secp256k1.GenPrivKey()
+	forged := served
+	forged.PubKey = otherKey.PubKey().Bytes()
+	err = attestation.Verify(forged, chainID, validator, privKey.PubKey())
+	require.True(t, errors.Is(err, attestation.ErrUnexpectedSigner))
+}
+
+func TestLoadPrivKey(t *testing.T) {
+	writeKey := func(content string) string {
+		tmpFile, err := ioutil.TempFile("", "attestation.key")
+		require.NoError(t, err)
+		t.Cleanup(func() { os.Remove(tmpFile.Name()) })
+
+		_, err = tmpFile.WriteString(content)
+		require.NoError(t, err)
+		require.NoError(t, tmpFile.Close())
+
+		return tmpFile.Name()
+	}
+
+	privKey := secp256k1.GenPrivKey()
+	loaded, err := attestation.LoadPrivKey(writeKey(hex.EncodeToString(privKey.Bytes()) + "\n"))
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(loaded.PubKey()))
+
+	_, err = attestation.LoadPrivKey(writeKey("not a key"))
+	require.Error(t, err)
+
+	_, err = attestation.LoadPrivKey(writeKey(hex.EncodeToString(privKey.Bytes()[:16])))
+	require.Error(t, err)
+
+	_, err = attestation.LoadPrivKey(writeKey("") + ".missing")
+	require.Error(t, err)
+}
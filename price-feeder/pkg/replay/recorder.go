@@ -0,0 +1,226 @@
+package replay
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// Recorder defines a local proxy to the REST and websocket endpoints of an
+// exchange. Every websocket message and REST response received from the
+// exchange is forwarded to the client and written as a frame to the recording.
+// Messages sent by the client, e.g. subscriptions, are forwarded but not
+// recorded, as the replay server does not need them.
+type Recorder struct {
+	logger    zerolog.Logger
+	rest      string
+	websocket string
+	client    *http.Client
+	server    *httptest.Server
+
+	mtx     sync.Mutex
+	encoder *json.Encoder
+	conns   map[*websocket.Conn]struct{}
+}
+
+// NewRecorder starts a Recorder proxying to the given REST and websocket
+// endpoints of an exchange, which writes its recording to w.
+func NewRecorder(logger zerolog.Logger, restEndpoint, wsEndpoint string, w io.Writer) *Recorder {
+	r := &Recorder{
+		logger:    logger.With().Str("module", "recorder").Logger(),
+		rest:      restEndpoint,
+		websocket: wsEndpoint,
+		client:    &http.Client{},
+		encoder:   json.NewEncoder(w),
+		conns:     make(map[*websocket.Conn]struct{}),
+	}
+	r.server = httptest.NewServer(r)
+
+	return r
+}
+
+// URL returns the local URL through which the given upstream REST or websocket
+// URL is proxied.
+func (r *Recorder) URL(upstream string) (string, error) {
+	return localURL(r.server.URL, upstream)
+}
+
+// Close closes the proxied websocket connections and shuts down the Recorder.
+func (r *Recorder) Close() {
+	r.mtx.Lock()
+	for conn := range r.conns {
+		conn.Close()
+	}
+	r.mtx.Unlock()
+
+	r.server.Close()
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if websocket.IsWebSocketUpgrade(req) {
+		r.proxyWebsocket(w, req)
+		return
+	}
+
+	r.proxyRest(w, req)
+}
+
+// proxyRest forwards the request to the REST endpoint of the exchange, and
+// records its response.
+func (r *Recorder) proxyRest(w http.ResponseWriter, req *http.Request) {
+	u, err := upstreamURL(r.rest, req.URL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	upstreamReq, err := http.NewRequestWithContext(req.Context(), req.Method, u, req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	upstreamReq.Header = req.Header.Clone()
+
+	// let the transport negotiate the encoding, so that compressed responses
+	// are decompressed before they are recorded
+	upstreamReq.Header.Del("Accept-Encoding")
+
+	resp, err := r.client.Do(upstreamReq)
+	if err != nil {
+		r.logger.Err(err).Str("url", u).Msg("failed to proxy request")
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		r.logger.Err(err).Str("url", u).Msg("failed to read response")
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	f := newFrame(KindRest, websocket.TextMessage, bz)
+	f.Path = req.URL.RequestURI()
+	f.Status = resp.StatusCode
+	r.record(f)
+
+	for key, values := range resp.Header {
+		for _, v := range values {
+			w.Header().Add(key, v)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(bz)
+}
+
+// proxyWebsocket connects to the websocket endpoint of the exchange, and pipes
+// the messages of both connections until either is closed. The messages
+// received from the exchange are recorded.
+func (r *Recorder) proxyWebsocket(w http.ResponseWriter, req *http.Request) {
+	u, err := upstreamURL(r.websocket, req.URL)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	upstream, _, err := websocket.DefaultDialer.DialContext(req.Context(), u, nil)
+	if err != nil {
+		r.logger.Err(err).Str("url", u).Msg("failed to connect to websocket")
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		upstream.Close()
+		return
+	}
+
+	r.track(conn, upstream)
+	defer r.untrack(conn, upstream)
+
+	go func() {
+		defer upstream.Close()
+
+		for {
+			messageType, bz, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := upstream.WriteMessage(messageType, bz); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		messageType, bz, err := upstream.ReadMessage()
+		if err != nil {
+			r.logger.Debug().Err(err).Str("url", u).Msg("websocket connection closed")
+			return
+		}
+
+		r.record(newFrame(KindWebsocket, messageType, bz))
+
+		if err := conn.WriteMessage(messageType, bz); err != nil {
+			return
+		}
+	}
+}
+
+// record writes the frame to the recording.
+func (r *Recorder) record(f Frame) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if err := r.encoder.Encode(f); err != nil {
+		r.logger.Err(err).Msg("failed to record frame")
+	}
+}
+
+func (r *Recorder) track(conns ...*websocket.Conn) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for _, conn := range conns {
+		r.conns[conn] = struct{}{}
+	}
+}
+
+func (r *Recorder) untrack(conns ...*websocket.Conn) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for _, conn := range conns {
+		conn.Close()
+		delete(r.conns, conn)
+	}
+}
+
+// upstreamURL returns the URL of the endpoint with the path and query of the
+// proxied request.
+func upstreamURL(endpoint string, reqURL *url.URL) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	u.Path = reqURL.Path
+	u.RawPath = reqURL.RawPath
+	u.RawQuery = reqURL.RawQuery
+
+	return u.String(), nil
+}
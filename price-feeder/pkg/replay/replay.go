@@ -0,0 +1,147 @@
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"unicode/utf8"
+
+	"github.com/gorilla/websocket"
+)
+
+// Frame kinds
+const (
+	KindWebsocket = "ws"
+	KindRest      = "rest"
+)
+
+// Frame defines a websocket message or REST response received from an exchange.
+// Text messages are kept as is so recordings can be read and edited by hand,
+// while binary messages, e.g. gzip compressed ones, are base64 encoded.
+type Frame struct {
+	Kind string `json:"kind"`
+
+	// Type is the websocket message type of a websocket frame.
+	Type int `json:"type,omitempty"`
+
+	// Path and Status are the request URI and the response status code of a
+	// REST frame.
+	Path   string `json:"path,omitempty"`
+	Status int    `json:"status,omitempty"`
+
+	Text   string `json:"text,omitempty"`
+	Binary []byte `json:"binary,omitempty"`
+}
+
+// TextFrame returns a websocket frame of a text message.
+func TextFrame(text string) Frame {
+	return Frame{
+		Kind: KindWebsocket,
+		Type: websocket.TextMessage,
+		Text: text,
+	}
+}
+
+// BinaryFrame returns a websocket frame of a binary message.
+func BinaryFrame(bz []byte) Frame {
+	return Frame{
+		Kind:   KindWebsocket,
+		Type:   websocket.BinaryMessage,
+		Binary: bz,
+	}
+}
+
+// newFrame returns the frame of a websocket message, or REST response body,
+// of the given kind. Payloads that are not valid UTF-8 are kept as binary.
+func newFrame(kind string, messageType int, bz []byte) Frame {
+	f := Frame{Kind: kind}
+	if kind == KindWebsocket {
+		f.Type = messageType
+	}
+
+	if messageType == websocket.BinaryMessage || !utf8.Valid(bz) {
+		f.Binary = bz
+	} else {
+		f.Text = string(bz)
+	}
+
+	return f
+}
+
+// Payload returns the raw websocket message or REST response body of the frame.
+func (f Frame) Payload() []byte {
+	if f.Binary != nil {
+		return f.Binary
+	}
+
+	return []byte(f.Text)
+}
+
+// Load returns the frames recorded in the file at the given path, which
+// contains a JSON encoded frame per line.
+func Load(path string) ([]Frame, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ReadFrames(file)
+}
+
+// ReadFrames returns the JSON encoded frames read from r, one per line. Empty
+// lines are skipped.
+func ReadFrames(r io.Reader) ([]Frame, error) {
+	var frames []Frame
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var f Frame
+		if err := json.Unmarshal(scanner.Bytes(), &f); err != nil {
+			return nil, fmt.Errorf("invalid frame at line %d: %w", line, err)
+		}
+
+		frames = append(frames, f)
+	}
+
+	return frames, scanner.Err()
+}
+
+// localURL returns the given upstream URL with its scheme and host replaced by
+// the ones of the local server at serverURL, so the path and query of the
+// upstream URL are kept. An empty upstream URL is returned as is.
+func localURL(serverURL, upstream string) (string, error) {
+	if len(upstream) == 0 {
+		return "", nil
+	}
+
+	server, err := url.Parse(serverURL)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(upstream)
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "ws", "wss":
+		u.Scheme = "ws"
+
+	default:
+		u.Scheme = "http"
+	}
+	u.Host = server.Host
+
+	return u.String(), nil
+}
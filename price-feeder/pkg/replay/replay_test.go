@@ -0,0 +1,209 @@
+package replay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newExchange returns a fake exchange answering REST requests with their path,
+// and every websocket message with a text and a binary message.
+func newExchange(t *testing.T) *httptest.Server {
+	exchange := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !websocket.IsWebSocketUpgrade(req) {
+			_, _ = w.Write([]byte(`{"path":"` + req.URL.RequestURI() + `"}`))
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, req, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		for {
+			_, bz, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			if err := conn.WriteMessage(websocket.TextMessage, append([]byte("ack "), bz...)); err != nil {
+				return
+			}
+			if err := conn.WriteMessage(websocket.BinaryMessage, []byte{0x1f, 0x8b, 0xff}); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(exchange.Close)
+
+	return exchange
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	exchange := newExchange(t)
+
+	var recording bytes.Buffer
+	recorder := NewRecorder(zerolog.Nop(), exchange.URL, "ws"+exchange.URL[len("http"):], &recording)
+
+	restURL, err := recorder.URL(exchange.URL + "/api/v1")
+	require.NoError(t, err)
+	resp, err := http.Get(restURL + "/ticker?symbol=ATOMUSDT")
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, `{"path":"/api/v1/ticker?symbol=ATOMUSDT"}`, string(body))
+
+	wsURL, err := recorder.URL("wss://exchange.test/ws")
+	require.NoError(t, err)
+	require.Regexp(t, "^ws://127.0.0.1:[0-9]+/ws$", wsURL)
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("subscribe")))
+
+	messageType, bz, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, websocket.TextMessage, messageType)
+	require.Equal(t, "ack subscribe", string(bz))
+
+	messageType, bz, err = conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, websocket.BinaryMessage, messageType)
+	require.Equal(t, []byte{0x1f, 0x8b, 0xff}, bz)
+
+	conn.Close()
+	recorder.Close()
+
+	frames, err := ReadFrames(&recording)
+	require.NoError(t, err)
+	require.Equal(t, []Frame{
+		{
+			Kind:   KindRest,
+			Path:   "/api/v1/ticker?symbol=ATOMUSDT",
+			Status: http.StatusOK,
+			Text:   `{"path":"/api/v1/ticker?symbol=ATOMUSDT"}`,
+		},
+		TextFrame("ack subscribe"),
+		BinaryFrame([]byte{0x1f, 0x8b, 0xff}),
+	}, frames)
+
+	t.Run("replay", func(t *testing.T) {
+		server := NewServer(frames, Options{})
+		defer server.Close()
+
+		restURL, err := server.URL("https://exchange.test/api/v1/ticker?symbol=ATOMUSDT")
+		require.NoError(t, err)
+		resp, err := http.Get(restURL)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, `{"path":"/api/v1/ticker?symbol=ATOMUSDT"}`, string(body))
+
+		resp, err = http.Get(restURL + "&limit=1")
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		wsURL, err := server.URL("wss://exchange.test/ws")
+		require.NoError(t, err)
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("subscribe")))
+
+		_, bz, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, "ack subscribe", string(bz))
+
+		messageType, bz, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, websocket.BinaryMessage, messageType)
+		require.Equal(t, []byte{0x1f, 0x8b, 0xff}, bz)
+
+		require.Eventually(t, func() bool {
+			return len(server.Received()) == 1
+		}, time.Second, 10*time.Millisecond)
+		require.Equal(t, "subscribe", string(server.Received()[0]))
+		require.Equal(t, 1, server.Connections())
+	})
+}
+
+func TestRecordAndReplay_Gzip(t *testing.T) {
+	exchange := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Contains(t, req.Header.Get("Accept-Encoding"), "gzip")
+
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+		_, _ = gz.Write([]byte(`{"price":"10"}`))
+	}))
+	defer exchange.Close()
+
+	var recording bytes.Buffer
+	recorder := NewRecorder(zerolog.Nop(), exchange.URL, "", &recording)
+
+	restURL, err := recorder.URL(exchange.URL + "/ticker")
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, restURL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	recorder.Close()
+
+	frames, err := ReadFrames(&recording)
+	require.NoError(t, err)
+	require.Len(t, frames, 1)
+	require.Equal(t, `{"price":"10"}`, frames[0].Text)
+
+	server := NewServer(frames, Options{})
+	defer server.Close()
+
+	restURL, err = server.URL(exchange.URL + "/ticker")
+	require.NoError(t, err)
+	resp, err = http.Get(restURL)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, `{"price":"10"}`, string(body))
+}
+
+func TestServer_DropAfter(t *testing.T) {
+	server := NewServer([]Frame{TextFrame("1"), TextFrame("2"), TextFrame("3")}, Options{DropAfter: 2})
+	defer server.Close()
+
+	wsURL, err := server.URL("wss://exchange.test/ws")
+	require.NoError(t, err)
+
+	for i := 1; i <= 2; i++ {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		require.NoError(t, err)
+
+		_, bz, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, "1", string(bz))
+
+		_, bz, err = conn.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, "2", string(bz))
+
+		_, _, err = conn.ReadMessage()
+		require.True(t, websocket.IsUnexpectedCloseError(err), err)
+
+		conn.Close()
+		require.Equal(t, i, server.Connections())
+	}
+}
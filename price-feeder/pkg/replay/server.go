@@ -0,0 +1,200 @@
+package replay
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type (
+	// Options defines how a Server replays its frames.
+	Options struct {
+		// Interval is the delay before each websocket frame is sent.
+		Interval time.Duration
+
+		// DropAfter is the number of websocket frames after which every
+		// connection is dropped, without a close handshake, as an exchange
+		// going away would. Zero means connections are never dropped.
+		DropAfter int
+	}
+
+	// Server defines a local server replaying recorded frames to providers.
+	// Every websocket connection is sent the recorded websocket frames in order,
+	// and every REST request is answered with the recorded responses to its
+	// request URI, in order, the last one being repeated.
+	Server struct {
+		frames []Frame
+		opts   Options
+		server *httptest.Server
+
+		mtx         sync.Mutex
+		connections int
+		received    [][]byte
+		restServed  map[string]int
+		conns       map[*websocket.Conn]struct{}
+	}
+)
+
+// NewServer starts a Server replaying the given frames.
+func NewServer(frames []Frame, opts Options) *Server {
+	s := &Server{
+		frames:     frames,
+		opts:       opts,
+		restServed: make(map[string]int),
+		conns:      make(map[*websocket.Conn]struct{}),
+	}
+	s.server = httptest.NewServer(s)
+
+	return s
+}
+
+// URL returns the local URL at which the given upstream REST or websocket URL
+// is replayed.
+func (s *Server) URL(upstream string) (string, error) {
+	return localURL(s.server.URL, upstream)
+}
+
+// Connections returns the number of websocket connections accepted so far.
+func (s *Server) Connections() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.connections
+}
+
+// Received returns the websocket messages received from clients so far, e.g.
+// subscriptions, in order.
+func (s *Server) Received() [][]byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	received := make([][]byte, len(s.received))
+	copy(received, s.received)
+
+	return received
+}
+
+// Close closes the websocket connections and shuts down the Server.
+func (s *Server) Close() {
+	s.mtx.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mtx.Unlock()
+
+	s.server.Close()
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if websocket.IsWebSocketUpgrade(req) {
+		s.serveWebsocket(w, req)
+		return
+	}
+
+	s.serveRest(w, req)
+}
+
+// serveRest answers the request with the next recorded response to its
+// request URI.
+func (s *Server) serveRest(w http.ResponseWriter, req *http.Request) {
+	path := req.URL.RequestURI()
+
+	var responses []Frame
+	for _, f := range s.frames {
+		if f.Kind == KindRest && f.Path == path {
+			responses = append(responses, f)
+		}
+	}
+
+	if len(responses) == 0 {
+		http.NotFound(w, req)
+		return
+	}
+
+	s.mtx.Lock()
+	i := s.restServed[path]
+	if i < len(responses)-1 {
+		s.restServed[path]++
+	}
+	s.mtx.Unlock()
+
+	status := responses[i].Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	w.WriteHeader(status)
+	_, _ = w.Write(responses[i].Payload())
+}
+
+// serveWebsocket sends the recorded websocket frames on a new connection, and
+// keeps it open until the client or the server closes it, or it is dropped.
+func (s *Server) serveWebsocket(w http.ResponseWriter, req *http.Request) {
+	conn, err := upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+
+	s.mtx.Lock()
+	s.connections++
+	s.conns[conn] = struct{}{}
+	s.mtx.Unlock()
+
+	defer func() {
+		s.mtx.Lock()
+		delete(s.conns, conn)
+		s.mtx.Unlock()
+
+		conn.Close()
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for {
+			_, bz, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			s.mtx.Lock()
+			s.received = append(s.received, bz)
+			s.mtx.Unlock()
+		}
+	}()
+
+	sent := 0
+	for _, f := range s.frames {
+		if f.Kind != KindWebsocket {
+			continue
+		}
+
+		select {
+		case <-done:
+			return
+		case <-time.After(s.opts.Interval):
+		}
+
+		messageType := f.Type
+		if messageType == 0 {
+			messageType = websocket.TextMessage
+		}
+
+		if err := conn.WriteMessage(messageType, f.Payload()); err != nil {
+			return
+		}
+		sent++
+
+		if sent == s.opts.DropAfter {
+			conn.UnderlyingConn().Close()
+			return
+		}
+	}
+
+	<-done
+}
@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/pkg/attestation"
+)
+
+// Attestor defines the signer of the price attestations served by the v1
+// router.
+type Attestor interface {
+	Sign(prices map[string]sdk.Dec, timestamp time.Time, blockHeight int64) (attestation.Attestation, error)
+}
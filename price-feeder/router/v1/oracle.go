@@ -4,10 +4,18 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/oracle"
+	"github.com/umee-network/umee/price-feeder/oracle/client"
 )
 
 // Oracle defines the Oracle interface contract that the v1 router depends on.
 type Oracle interface {
 	GetLastPriceSyncTimestamp() time.Time
 	GetPrices() map[string]sdk.Dec
+	GetPriceSnapshot() oracle.PriceSnapshot
+	GetActiveNode() client.NodeEndpoints
+	GetPriceReport() oracle.PriceReport
+	GetVoteStatus() oracle.VoteStatus
+	GetProviderHealth() map[string]map[string]oracle.ProviderHealth
 }
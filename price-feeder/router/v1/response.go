@@ -5,11 +5,15 @@ import (
 	"net/http"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/price-feeder/oracle"
+	"github.com/umee-network/umee/price-feeder/pkg/attestation"
 )
 
 // Response constants
 const (
 	StatusAvailable = "available"
+	StatusDegraded  = "degraded"
 )
 
 type (
@@ -19,6 +23,10 @@ type (
 		Oracle struct {
 			LastSync string `json:"last_sync"`
 		} `json:"oracle"`
+		RPC struct {
+			TMRPCEndpoint string `json:"tmrpc_endpoint"`
+			GRPCEndpoint  string `json:"grpc_endpoint"`
+		} `json:"rpc"`
 	}
 
 	// PricesResponse defines the response type for getting the latest exchange
@@ -26,6 +34,30 @@ type (
 	PricesResponse struct {
 		Prices map[string]sdk.Dec `json:"prices"`
 	}
+
+	// PriceAttestationResponse defines the response type for getting the
+	// latest exchange rates signed by the attestation key of the validator.
+	PriceAttestationResponse struct {
+		attestation.Attestation
+	}
+
+	// ProviderPricesResponse defines the response type for getting the provider
+	// data from which the latest exchange rates were computed.
+	ProviderPricesResponse struct {
+		oracle.PriceReport
+	}
+
+	// VotesResponse defines the response type for getting the last pre-vote and
+	// vote broadcast by the oracle.
+	VotesResponse struct {
+		oracle.VoteStatus
+	}
+
+	// ProviderHealthResponse defines the response type for getting the health
+	// of every provider pair, keyed by provider and pair symbol.
+	ProviderHealthResponse struct {
+		Providers map[string]map[string]oracle.ProviderHealth `json:"providers"`
+	}
 )
 
 // errorResponse defines the attributes of a JSON error response.
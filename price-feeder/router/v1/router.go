@@ -20,18 +20,22 @@ const (
 
 // Router defines a router wrapper used for registering v1 API routes.
 type Router struct {
-	logger  zerolog.Logger
-	cfg     config.Config
-	oracle  Oracle
-	metrics Metrics
+	logger   zerolog.Logger
+	cfg      config.Config
+	oracle   Oracle
+	metrics  Metrics
+	attestor Attestor
 }
 
-func New(logger zerolog.Logger, cfg config.Config, oracle Oracle, metrics Metrics) *Router {
+// New returns a new v1 Router. Price attestations are only served if an
+// attestor is given.
+func New(logger zerolog.Logger, cfg config.Config, oracle Oracle, metrics Metrics, attestor Attestor) *Router {
 	return &Router{
-		logger:  logger.With().Str("module", "router").Logger(),
-		cfg:     cfg,
-		oracle:  oracle,
-		metrics: metrics,
+		logger:   logger.With().Str("module", "router").Logger(),
+		cfg:      cfg,
+		oracle:   oracle,
+		metrics:  metrics,
+		attestor: attestor,
 	}
 }
 
@@ -66,6 +70,28 @@ func (r *Router) RegisterRoutes(rtr *mux.Router, prefix string) {
 		mChain.ThenFunc(r.pricesHandler()),
 	).Methods(httputil.MethodGET)
 
+	v1Router.Handle(
+		"/prices/providers",
+		mChain.ThenFunc(r.providerPricesHandler()),
+	).Methods(httputil.MethodGET)
+
+	if r.attestor != nil {
+		v1Router.Handle(
+			"/prices/attestation",
+			mChain.ThenFunc(r.priceAttestationHandler()),
+		).Methods(httputil.MethodGET)
+	}
+
+	v1Router.Handle(
+		"/providers/health",
+		mChain.ThenFunc(r.providerHealthHandler()),
+	).Methods(httputil.MethodGET)
+
+	v1Router.Handle(
+		"/votes",
+		mChain.ThenFunc(r.votesHandler()),
+	).Methods(httputil.MethodGET)
+
 	if r.cfg.Telemetry.Enabled {
 		v1Router.Handle(
 			"/metrics",
@@ -74,15 +100,31 @@ func (r *Router) RegisterRoutes(rtr *mux.Router, prefix string) {
 	}
 }
 
+// healthzHandler reports a degraded status, with a 503 status code, when prices
+// were last synced successfully longer than the stale price timeout ago.
 func (r *Router) healthzHandler() http.HandlerFunc {
+	// the timeout is validated by the config, a zero timeout disables the check
+	staleTimeout, _ := time.ParseDuration(r.cfg.Server.StalePriceTimeout)
+
 	return func(w http.ResponseWriter, req *http.Request) {
 		resp := HealthZResponse{
 			Status: StatusAvailable,
 		}
+		status := http.StatusOK
 
-		resp.Oracle.LastSync = r.oracle.GetLastPriceSyncTimestamp().Format(time.RFC3339)
+		lastSync := r.oracle.GetLastPriceSyncTimestamp()
+		if staleTimeout > 0 && time.Since(lastSync) > staleTimeout {
+			resp.Status = StatusDegraded
+			status = http.StatusServiceUnavailable
+		}
 
-		httputil.RespondWithJSON(w, http.StatusOK, resp)
+		resp.Oracle.LastSync = lastSync.Format(time.RFC3339)
+
+		node := r.oracle.GetActiveNode()
+		resp.RPC.TMRPCEndpoint = node.TMRPC
+		resp.RPC.GRPCEndpoint = node.GRPC
+
+		httputil.RespondWithJSON(w, status, resp)
 	}
 }
 
@@ -96,6 +138,57 @@ func (r *Router) pricesHandler() http.HandlerFunc {
 	}
 }
 
+// priceAttestationHandler returns the current prices, signed along with the
+// time and block height they were computed at.
+func (r *Router) priceAttestationHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		snapshot := r.oracle.GetPriceSnapshot()
+		if snapshot.Timestamp.IsZero() {
+			writeErrorResponse(w, http.StatusServiceUnavailable, "no prices to attest yet")
+			return
+		}
+
+		a, err := r.attestor.Sign(snapshot.Prices, snapshot.Timestamp, snapshot.BlockHeight)
+		if err != nil {
+			r.logger.Err(err).Msg("failed to sign price attestation")
+			writeErrorResponse(w, http.StatusInternalServerError, "failed to sign price attestation")
+			return
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, PriceAttestationResponse{Attestation: a})
+	}
+}
+
+func (r *Router) providerPricesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp := ProviderPricesResponse{
+			PriceReport: r.oracle.GetPriceReport(),
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, resp)
+	}
+}
+
+func (r *Router) providerHealthHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp := ProviderHealthResponse{
+			Providers: r.oracle.GetProviderHealth(),
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, resp)
+	}
+}
+
+func (r *Router) votesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp := VotesResponse{
+			VoteStatus: r.oracle.GetVoteStatus(),
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, resp)
+	}
+}
+
 func (r *Router) metricsHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		format := strings.TrimSpace(req.FormValue("format"))
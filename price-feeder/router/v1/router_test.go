@@ -7,35 +7,122 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/umee-network/umee/price-feeder/config"
+	"github.com/umee-network/umee/price-feeder/oracle"
+	"github.com/umee-network/umee/price-feeder/oracle/client"
+	"github.com/umee-network/umee/price-feeder/oracle/provider"
+	"github.com/umee-network/umee/price-feeder/pkg/attestation"
 	v1 "github.com/umee-network/umee/price-feeder/router/v1"
 	"github.com/umee-network/umee/price-feeder/telemetry"
 )
 
+const (
+	mockChainID   = "umee-local-testnet"
+	mockValidator = "umeevaloper12tysz6mzrawenca2t3t7ltym4hfjj8a5upsn2k"
+)
+
 var (
 	_ v1.Oracle = (*mockOracle)(nil)
 
+	mockAttestationKey = secp256k1.GenPrivKey()
+	mockPriceTimestamp = time.Date(2022, 3, 14, 12, 0, 0, 0, time.UTC)
+
 	mockPrices = map[string]sdk.Dec{
 		"ATOM": sdk.MustNewDecFromStr("34.84"),
 		"UMEE": sdk.MustNewDecFromStr("4.21"),
 	}
+
+	mockNode = client.NodeEndpoints{
+		TMRPC: "http://localhost:26657",
+		GRPC:  "localhost:9090",
+	}
+
+	mockPriceReport = oracle.PriceReport{
+		Tickers: provider.AggregatedProviderPrices{
+			"kraken": {
+				"ATOM": {Price: sdk.MustNewDecFromStr("34.84"), Volume: sdk.MustNewDecFromStr("1000")},
+			},
+			"binance": {
+				"ATOM": {Price: sdk.MustNewDecFromStr("39.12"), Volume: sdk.MustNewDecFromStr("2000")},
+			},
+		},
+		DeviatingTickers: map[string][]string{
+			"binance": {"ATOM"},
+		},
+		VWAP: map[string]sdk.Dec{
+			"ATOM": sdk.MustNewDecFromStr("34.84"),
+		},
+	}
+
+	mockVoteStatus = oracle.VoteStatus{
+		Prevote: &oracle.TxResult{
+			Hash:          "5d7de2c6e1b2ea1a27b1b27b8f2f1a3d2d6a0c1e",
+			ExchangeRates: "ATOM:34.840000000000000000",
+			BlockHeight:   100,
+			TxHash:        "A1B2C3",
+			TxHeight:      101,
+		},
+	}
+
+	mockProviderHealth = map[string]map[string]oracle.ProviderHealth{
+		provider.ProviderBinance: {
+			"ATOMUSDT": {
+				Score:                 0.25,
+				ConsecutiveDeviations: 3,
+				Deviations:            3,
+				Quarantined:           true,
+				Quarantines:           1,
+			},
+		},
+	}
 )
 
-type mockOracle struct{}
+// mockOracle reports prices as last synced now, unless lastSync is set.
+type mockOracle struct {
+	lastSync time.Time
+}
 
 func (m mockOracle) GetLastPriceSyncTimestamp() time.Time {
-	return time.Now()
+	if m.lastSync.IsZero() {
+		return time.Now()
+	}
+	return m.lastSync
 }
 
 func (m mockOracle) GetPrices() map[string]sdk.Dec {
 	return mockPrices
 }
 
+func (m mockOracle) GetPriceSnapshot() oracle.PriceSnapshot {
+	return oracle.PriceSnapshot{
+		Prices:      mockPrices,
+		Timestamp:   mockPriceTimestamp,
+		BlockHeight: 1234,
+	}
+}
+
+func (m mockOracle) GetActiveNode() client.NodeEndpoints {
+	return mockNode
+}
+
+func (m mockOracle) GetPriceReport() oracle.PriceReport {
+	return mockPriceReport
+}
+
+func (m mockOracle) GetVoteStatus() oracle.VoteStatus {
+	return mockVoteStatus
+}
+
+func (m mockOracle) GetProviderHealth() map[string]map[string]oracle.ProviderHealth {
+	return mockProviderHealth
+}
+
 type mockMetrics struct{}
 
 func (mockMetrics) Gather(format string) (telemetry.GatherResponse, error) {
@@ -59,7 +146,8 @@ func (rts *RouterTestSuite) SetupSuite() {
 		},
 	}
 
-	r := v1.New(zerolog.Nop(), cfg, mockOracle{}, mockMetrics{})
+	attestor := attestation.NewSigner(mockAttestationKey, mockChainID, mockValidator)
+	r := v1.New(zerolog.Nop(), cfg, mockOracle{}, mockMetrics{}, attestor)
 	r.RegisterRoutes(mux, v1.APIPathPrefix)
 
 	rts.mux = mux
@@ -84,9 +172,11 @@ func (rts *RouterTestSuite) TestHealthz() {
 	response := rts.executeRequest(req)
 	rts.Require().Equal(http.StatusOK, response.Code)
 
-	var respBody map[string]interface{}
+	var respBody v1.HealthZResponse
 	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
-	rts.Require().Equal(respBody["status"], v1.StatusAvailable)
+	rts.Require().Equal(respBody.Status, v1.StatusAvailable)
+	rts.Require().Equal(respBody.RPC.TMRPCEndpoint, mockNode.TMRPC)
+	rts.Require().Equal(respBody.RPC.GRPCEndpoint, mockNode.GRPC)
 }
 
 func (rts *RouterTestSuite) TestPrices() {
@@ -102,3 +192,102 @@ func (rts *RouterTestSuite) TestPrices() {
 	rts.Require().Equal(respBody.Prices["UMEE"], mockPrices["UMEE"])
 	rts.Require().Equal(respBody.Prices["FOO"], sdk.Dec{})
 }
+
+func (rts *RouterTestSuite) TestHealthz_Degraded() {
+	mux := mux.NewRouter()
+	cfg := config.Config{
+		Server: config.Server{
+			StalePriceTimeout: "1m",
+		},
+	}
+
+	r := v1.New(zerolog.Nop(), cfg, mockOracle{lastSync: time.Now().Add(-time.Hour)}, mockMetrics{}, nil)
+	r.RegisterRoutes(mux, v1.APIPathPrefix)
+
+	req, err := http.NewRequest("GET", "/api/v1/healthz", nil)
+	rts.Require().NoError(err)
+
+	response := httptest.NewRecorder()
+	mux.ServeHTTP(response, req)
+	rts.Require().Equal(http.StatusServiceUnavailable, response.Code)
+
+	var respBody v1.HealthZResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(respBody.Status, v1.StatusDegraded)
+}
+
+func (rts *RouterTestSuite) TestProviderPrices() {
+	req, err := http.NewRequest("GET", "/api/v1/prices/providers", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.ProviderPricesResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(mockPriceReport.Tickers, respBody.Tickers)
+	rts.Require().Equal(mockPriceReport.DeviatingTickers, respBody.DeviatingTickers)
+	rts.Require().Equal(mockPriceReport.VWAP, respBody.VWAP)
+}
+
+func (rts *RouterTestSuite) TestVotes() {
+	req, err := http.NewRequest("GET", "/api/v1/votes", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.VotesResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(mockVoteStatus.Prevote, respBody.Prevote)
+	rts.Require().Nil(respBody.Vote)
+}
+
+func (rts *RouterTestSuite) TestProviderHealth() {
+	req, err := http.NewRequest("GET", "/api/v1/providers/health", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.ProviderHealthResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+
+	health := respBody.Providers[provider.ProviderBinance]["ATOMUSDT"]
+	rts.Require().True(health.Quarantined)
+	rts.Require().Equal(0.25, health.Score)
+	rts.Require().Equal(3, health.ConsecutiveDeviations)
+}
+
+func (rts *RouterTestSuite) TestPriceAttestation() {
+	req, err := http.NewRequest("GET", "/api/v1/prices/attestation", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.PriceAttestationResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().NoError(attestation.Verify(
+		respBody.Attestation,
+		mockChainID,
+		mockValidator,
+		mockAttestationKey.PubKey(),
+	))
+	rts.Require().Equal(mockPrices, respBody.PriceMap())
+	rts.Require().Equal(int64(1234), respBody.BlockHeight)
+	rts.Require().True(mockPriceTimestamp.Equal(respBody.Timestamp))
+}
+
+func (rts *RouterTestSuite) TestPriceAttestation_Disabled() {
+	mux := mux.NewRouter()
+	r := v1.New(zerolog.Nop(), config.Config{}, mockOracle{}, mockMetrics{}, nil)
+	r.RegisterRoutes(mux, v1.APIPathPrefix)
+
+	req, err := http.NewRequest("GET", "/api/v1/prices/attestation", nil)
+	rts.Require().NoError(err)
+
+	response := httptest.NewRecorder()
+	mux.ServeHTTP(response, req)
+	rts.Require().NotEqual(http.StatusOK, response.Code)
+}
@@ -22,7 +22,7 @@ func NewUpdateRegistryProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleUpdateRegistryProposalHandler(ctx sdk.Context, k keeper.Keeper, p *types.UpdateRegistryProposal) error {
-	if err := k.DeleteRegisteredTokens(ctx); err != nil {
+	if err := k.DeleteRegisteredTokens(ctx, p.Registry); err != nil {
 		return err
 	}
 
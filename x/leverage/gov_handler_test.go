@@ -55,5 +55,13 @@ func TestUpdateRegistryProposalHandler(t *testing.T) {
 		token, err := k.GetRegisteredToken(ctx, "uatom")
 		require.NoError(t, err)
 		require.Equal(t, "0.020000000000000000", token.BaseBorrowRate.String())
+
+		// only the delisted token is marked optional in the oracle accept list
+		acceptList := app.OracleKeeper.AcceptList(ctx)
+		for _, denom := range []string{"uumee", "uatom", "uosmo"} {
+			d, _, ok := acceptList.GetByBase(denom)
+			require.True(t, ok)
+			require.Equal(t, denom == "uosmo", d.Optional)
+		}
 	})
 }
@@ -67,13 +67,22 @@ func (k Keeper) SetRegisteredToken(ctx sdk.Context, token types.Token) {
 }
 
 // DeleteRegisteredTokens deletes all registered tokens from the x/leverage
-// module's KVStore.
-func (k Keeper) DeleteRegisteredTokens(ctx sdk.Context) error {
+// module's KVStore. Tokens whose base denom is part of the given registry are
+// about to be registered again, so the AfterRegisteredTokenRemoved hook is only
+// executed for the remaining ones, which are being delisted.
+func (k Keeper) DeleteRegisteredTokens(ctx sdk.Context, registry []types.Token) error {
 	tokens := k.GetAllRegisteredTokens(ctx)
 
+	relisted := make(map[string]bool, len(registry))
+	for _, t := range registry {
+		relisted[t.BaseDenom] = true
+	}
+
 	for _, t := range tokens {
 		k.DeleteRegisteredToken(ctx, t.BaseDenom)
-		k.hooks.AfterRegisteredTokenRemoved(ctx, t)
+		if !relisted[t.BaseDenom] {
+			k.hooks.AfterRegisteredTokenRemoved(ctx, t)
+		}
 	}
 
 	return nil
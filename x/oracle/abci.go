@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"strings"
 	"time"
 
 	"github.com/umee-network/umee/x/oracle/keeper"
@@ -19,6 +20,9 @@ func IsPeriodLastBlock(ctx sdk.Context, blocksPerPeriod uint64) bool {
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// Expire the exchange rates pinned by governance before any new tally
+	k.ExpireExchangeRateOverrides(ctx)
+
 	params := k.GetParams(ctx)
 	if IsPeriodLastBlock(ctx, params.VotePeriod) {
 		// Build claim map over all validators in active set
@@ -42,40 +46,94 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 			}
 		}
 
-		var (
-			// voteTargets defines the symbol (ticker) denoms that we require votes on
-			voteTargets      []string
-			voteTargetDenoms []string
-		)
-		for _, v := range params.AcceptList {
-			voteTargets = append(voteTargets, v.SymbolDenom)
-			voteTargetDenoms = append(voteTargetDenoms, v.BaseDenom)
+		// voteTargets defines the symbol (ticker) denoms that we require votes on,
+		// with cross pairs (e.g. STATOM/ATOM) in place of their base
+		voteTargets := params.VoteTargets()
+		requiredDenoms := make(map[string]bool, len(voteTargets))
+		for _, denom := range voteTargets {
+			requiredDenoms[strings.ToUpper(denom)] = true
 		}
 
-		// Clear all exchange rates
+		// Clear all exchange rates and their derivations
 		k.IterateExchangeRates(ctx, func(denom string, _ sdk.Dec) (stop bool) {
 			k.DeleteExchangeRate(ctx, denom)
 			return false
 		})
+		k.IterateExchangeRateDerivations(ctx, func(derivation types.ExchangeRateDerivation) (stop bool) {
+			k.DeleteExchangeRateDerivation(ctx, derivation.Denom)
+			return false
+		})
 
 		// Organize votes to ballot by denom
 		// NOTE: **Filter out inactive or jailed validators**
 		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 
+		// Until price feeders vote on cross pairs, the USD vote on the base of a
+		// cross pair is accepted in place of the cross pair vote of validators
+		// that didn't cast one. crossVoters holds the voters of every cross pair
+		// ballot, keyed by the pair's base.
+		crossVoters := make(map[string]map[string]bool, len(params.CrossPairList))
+		for denom, ballot := range voteMap {
+			cp, ok := params.CrossPairList.Get(denom)
+			if !ok {
+				continue
+			}
+
+			voters := make(map[string]bool, len(ballot))
+			for _, vote := range ballot {
+				voters[vote.Voter.String()] = true
+			}
+			crossVoters[strings.ToUpper(cp.Base)] = voters
+		}
+
+		voteTarget := func(vote types.VoteForTally) (string, bool) {
+			denom := strings.ToUpper(vote.Denom)
+			if cp, ok := params.CrossPairList.GetByBase(denom); ok {
+				if crossVoters[denom][vote.Voter.String()] {
+					return denom, false
+				}
+				return cp.Denom(), requiredDenoms[cp.Denom()]
+			}
+
+			return denom, requiredDenoms[denom]
+		}
+
 		ballotDenomSlice := types.BallotMapToSlice(voteMap)
 
 		// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
+		crossRates := make(map[string]sdk.Dec)
 		for _, ballotDenom := range ballotDenomSlice {
 			// Get weighted median of exchange rates
-			exchangeRate, err := Tally(ctx, ballotDenom.Ballot, params.RewardBand, validatorClaimMap)
+			exchangeRate, err := Tally(
+				ctx,
+				ballotDenom.Ballot,
+				params.RewardBand,
+				validatorClaimMap,
+				voteTarget,
+			)
 			if err != nil {
 				return err
 			}
 
+			// Cross pair rates are not denominated in USD, they are only used to
+			// derive the USD rate of their base once all ballots are tallied. The
+			// USD rate tallied for a base is replaced by the derived one if any.
+			if params.CrossPairList.Contains(ballotDenom.Denom) {
+				crossRates[ballotDenom.Denom] = exchangeRate
+				continue
+			}
+
 			// Set the exchange rate, emit ABCI event
 			k.SetExchangeRateWithEvent(ctx, ballotDenom.Denom, exchangeRate)
 		}
 
+		// Derive USD exchange rates from the tallied cross pairs
+		k.SetCrossExchangeRates(ctx, params.CrossPairList, crossRates)
+
+		// Pin the exchange rates overridden by governance of denoms without a
+		// valid tally
+		k.ApplyExchangeRateOverrides(ctx)
+
 		// update miss counting & slashing
 		voteTargetsLen := len(voteTargets)
 		claimSlice := types.ClaimMapToSlice(validatorClaimMap)
@@ -87,16 +145,16 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 
 			// Increase miss counter
 			k.SetMissCounter(ctx, claim.Recipient, k.GetMissCounter(ctx, claim.Recipient)+1)
+
+			// Validators that voted on every target but not within the reward band
+			// are tracked separately from absent ones
+			if int(claim.VoteCount) >= voteTargetsLen {
+				k.SetOutOfBandCounter(ctx, claim.Recipient, k.GetOutOfBandCounter(ctx, claim.Recipient)+1)
+			}
 		}
 
 		// Distribute rewards to ballot winners
-		k.RewardBallotWinners(
-			ctx,
-			int64(params.VotePeriod),
-			int64(params.RewardDistributionWindow),
-			voteTargetDenoms,
-			validatorClaimMap,
-		)
+		k.RewardBallotWinners(ctx, validatorClaimMap)
 
 		// Clear the ballot
 		k.ClearBallots(ctx, params.VotePeriod)
@@ -113,12 +171,17 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 
 // Tally calculates the median and returns it. It sets the set of voters to be
 // rewarded, i.e. voted within a reasonable spread from the weighted median to
-// the store. Note, the ballot is sorted by ExchangeRate.
+// the store. voteTarget returns the vote target a vote is credited to, which
+// weighs its rewards, and whether that target is required. Votes that are not
+// required (e.g. of an optional denom) are rewarded, but don't count towards
+// the win and vote counts used for miss counting. Note, the ballot is sorted by
+// ExchangeRate.
 func Tally(
 	ctx sdk.Context,
 	ballot types.ExchangeRateBallot,
 	rewardBand sdk.Dec,
 	validatorClaimMap map[string]types.Claim,
+	voteTarget func(vote types.VoteForTally) (target string, required bool),
 ) (sdk.Dec, error) {
 	weightedMedian, err := ballot.WeightedMedian()
 	if err != nil {
@@ -134,19 +197,27 @@ func Tally(
 	rewardSpread = sdk.MaxDec(rewardSpread, standardDeviation)
 
 	for _, tallyVote := range ballot {
+		key := tallyVote.Voter.String()
+		claim := validatorClaimMap[key]
+		target, required := voteTarget(tallyVote)
+		if required {
+			claim.VoteCount++
+		}
+
 		// Filter ballot winners. For voters, we filter out the tally vote iff:
 		// (weightedMedian - rewardSpread) <= ExchangeRate <= (weightedMedian + rewardSpread)
 		if (tallyVote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
 			tallyVote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))) ||
 			!tallyVote.ExchangeRate.IsPositive() {
 
-			key := tallyVote.Voter.String()
-			claim := validatorClaimMap[key]
-
 			claim.Weight += tallyVote.Power
-			claim.WinCount++
-			validatorClaimMap[key] = claim
+			claim.DenomWeights[target] += tallyVote.Power
+			if required {
+				claim.WinCount++
+			}
 		}
+
+		validatorClaimMap[key] = claim
 	}
 
 	return weightedMedian, nil
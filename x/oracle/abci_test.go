@@ -0,0 +1,174 @@
+package oracle_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	umeeapp "github.com/umee-network/umee/app"
+	"github.com/umee-network/umee/x/oracle"
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+const initialPower = int64(10000000000)
+
+// Test addresses
+var (
+	valPubKeys = simapp.CreateTestPubKeys(2)
+
+	valPubKey = valPubKeys[0]
+	pubKey    = secp256k1.GenPrivKey().PubKey()
+	addr      = sdk.AccAddress(pubKey.Address())
+	valAddr   = sdk.ValAddress(pubKey.Address())
+
+	valPubKey2 = valPubKeys[1]
+	pubKey2    = secp256k1.GenPrivKey().PubKey()
+	addr2      = sdk.AccAddress(pubKey2.Address())
+	valAddr2   = sdk.ValAddress(pubKey2.Address())
+
+	initTokens = sdk.TokensFromConsensusPower(initialPower, sdk.DefaultPowerReduction)
+	initCoins  = sdk.NewCoins(sdk.NewCoin(umeeapp.BondDenom, initTokens))
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *umeeapp.UmeeApp
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	app := umeeapp.Setup(s.T(), false, 1)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID: fmt.Sprintf("test-chain-%s", tmrand.Str(4)),
+		Height:  9,
+	})
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+
+	// mint and send coins to validators
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, initCoins))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, initCoins))
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, sdk.NewCoin(types.UmeeDenom, amt),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+	s.Require().NoError(err)
+	_, err = sh(ctx, msg)
+	s.Require().NoError(err)
+
+	msg, err = stakingtypes.NewMsgCreateValidator(
+		valAddr2, valPubKey2, sdk.NewCoin(types.UmeeDenom, amt),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+	s.Require().NoError(err)
+	_, err = sh(ctx, msg)
+	s.Require().NoError(err)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	s.app = app
+	s.ctx = ctx
+}
+
+// setCrossPairParams accepts UMEE and ATOM against USD, and STATOM against
+// ATOM, and moves the context to the last block of a vote period.
+func (s *IntegrationTestSuite) setCrossPairParams() types.Params {
+	params := types.DefaultParams()
+	params.AcceptList = types.DenomList{
+		{BaseDenom: types.UmeeDenom, SymbolDenom: types.UmeeSymbol, Exponent: types.UmeeExponent},
+		{BaseDenom: "ibc/atom", SymbolDenom: "ATOM", Exponent: 6},
+		{BaseDenom: "ibc/statom", SymbolDenom: "STATOM", Exponent: 6},
+	}
+	params.CrossPairList = types.CrossPairList{{Base: "STATOM", Quote: "ATOM"}}
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(int64(params.VotePeriod) - 1)
+
+	return params
+}
+
+func (s *IntegrationTestSuite) setVote(voter sdk.ValAddress, rates string) {
+	tuples, err := types.ParseExchangeRateTuples(rates)
+	s.Require().NoError(err)
+	s.app.OracleKeeper.SetAggregateExchangeRateVote(s.ctx, voter, types.NewAggregateExchangeRateVote(tuples, voter))
+}
+
+func (s *IntegrationTestSuite) TestEndBlocker_CrossPairUSDFallback() {
+	app := s.app
+	s.setCrossPairParams()
+
+	// valAddr votes on the cross pair while valAddr2 only votes the USD rate of
+	// its base, which is accepted in place of the cross pair vote
+	s.setVote(valAddr, "UMEE:2,ATOM:10,STATOM/ATOM:1.1")
+	s.setVote(valAddr2, "UMEE:2,ATOM:10,STATOM:11")
+	s.Require().NoError(oracle.EndBlocker(s.ctx, app.OracleKeeper))
+
+	s.Require().Equal(uint64(0), app.OracleKeeper.GetMissCounter(s.ctx, valAddr))
+	s.Require().Equal(uint64(0), app.OracleKeeper.GetMissCounter(s.ctx, valAddr2))
+
+	// the derived rate takes precedence over the USD ballot of the base
+	rate, err := app.OracleKeeper.GetExchangeRate(s.ctx, "STATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("11"), rate)
+	_, err = app.OracleKeeper.GetExchangeRateDerivation(s.ctx, "STATOM")
+	s.Require().NoError(err)
+
+	// without a cross pair ballot, the USD ballot of the base sets its rate
+	s.setVote(valAddr, "UMEE:2,ATOM:10,STATOM:12")
+	s.setVote(valAddr2, "UMEE:2,ATOM:10")
+	s.Require().NoError(oracle.EndBlocker(s.ctx, app.OracleKeeper))
+
+	s.Require().Equal(uint64(0), app.OracleKeeper.GetMissCounter(s.ctx, valAddr))
+	s.Require().Equal(uint64(1), app.OracleKeeper.GetMissCounter(s.ctx, valAddr2))
+
+	rate, err = app.OracleKeeper.GetExchangeRate(s.ctx, "STATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("12"), rate)
+	_, err = app.OracleKeeper.GetExchangeRateDerivation(s.ctx, "STATOM")
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestEndBlocker_CrossPairOverriddenQuote() {
+	app := s.app
+	s.setCrossPairParams()
+
+	// governance pins the rate of ATOM, which validators cannot price
+	s.Require().NoError(app.OracleKeeper.OverrideExchangeRate(s.ctx, "ATOM", sdk.MustNewDecFromStr("10"), 100))
+
+	s.setVote(valAddr, "UMEE:2,STATOM/ATOM:1.1")
+	s.setVote(valAddr2, "UMEE:2,STATOM/ATOM:1.1")
+	s.Require().NoError(oracle.EndBlocker(s.ctx, app.OracleKeeper))
+
+	// the pinned quote rate is used to derive the rate of the cross pair's base
+	rate, err := app.OracleKeeper.GetExchangeRate(s.ctx, "STATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("11"), rate)
+	derivation, err := app.OracleKeeper.GetExchangeRateDerivation(s.ctx, "STATOM")
+	s.Require().NoError(err)
+	s.Require().Equal("STATOM/ATOM,ATOM/USD", derivation.PathString())
+
+	rate, err = app.OracleKeeper.GetExchangeRate(s.ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("10"), rate)
+	override, err := app.OracleKeeper.GetExchangeRateOverride(s.ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().True(override.Applied)
+}
+
+func TestOracleTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
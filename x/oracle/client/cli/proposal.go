@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+// ParseExchangeRateOverrideProposal attempts to parse a
+// ExchangeRateOverrideProposal from a JSON file.
+func ParseExchangeRateOverrideProposal(
+	cdc codec.JSONCodec,
+	proposalFile string,
+) (types.ExchangeRateOverrideProposal, error) {
+	content := types.ExchangeRateOverrideProposal{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return content, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &content); err != nil {
+		return content, err
+	}
+
+	return content, nil
+}
@@ -0,0 +1,45 @@
+package cli_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	umeeapp "github.com/umee-network/umee/app"
+	"github.com/umee-network/umee/x/oracle/client/cli"
+)
+
+func TestParseExchangeRateOverrideProposal(t *testing.T) {
+	encCfg := umeeapp.MakeEncodingConfig()
+	tmpDir := t.TempDir()
+
+	// create a bogus proposal file and ensure parsing fails
+	filePath := path.Join(tmpDir, "bad_proposal.json")
+	bz := []byte(`
+		foo
+	`)
+	os.WriteFile(filePath, bz, 0644)
+
+	_, err := cli.ParseExchangeRateOverrideProposal(encCfg.Marshaler, filePath)
+	require.Error(t, err)
+
+	// create a good proposal file and ensure parsing does not fail
+	filePath = path.Join(tmpDir, "good_proposal.json")
+	bz = []byte(`{
+	"title": "Pin the ATOM Exchange Rate",
+	"description": "Pin the exchange rate of ATOM while price feeds are down.",
+	"denom": "ATOM",
+	"exchange_rate": "25.5",
+	"duration": "1000"
+}`)
+	os.WriteFile(filePath, bz, 0644)
+
+	proposal, err := cli.ParseExchangeRateOverrideProposal(encCfg.Marshaler, filePath)
+	require.NoError(t, err)
+	require.Equal(t, "ATOM", proposal.Denom)
+	require.Equal(t, sdk.MustNewDecFromStr("25.5"), proposal.ExchangeRate)
+	require.Equal(t, uint64(1000), proposal.Duration)
+}
@@ -29,6 +29,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryExchangeRates(),
 		GetCmdQueryExchangeRate(),
+		GetCmdQueryExchangeRateDerivations(),
+		GetCmdQueryExchangeRateOverrides(),
+		GetCmdQueryRewardPools(),
 		GetCmdQueryFeederDelegation(),
 	)
 
@@ -231,6 +234,146 @@ $ umeed query oracle exchange-rate ATOM
 	return cmd
 }
 
+// GetCmdQueryExchangeRateDerivations implements the query rate derivations
+// command.
+func GetCmdQueryExchangeRateDerivations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-derivations [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the derivation paths of exchange rates derived from cross pairs",
+		Long: strings.TrimSpace(`
+Query the paths through which the current USD exchange rates of assets voted
+against a non-USD quote were derived.
+
+$ umeed query oracle exchange-rate-derivations
+
+Or, you can filter with denom
+
+$ umeed query oracle exchange-rate-derivations STATOM
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var denom string
+			if len(args) > 0 {
+				denom = args[0]
+			}
+
+			res, err := queryClient.ExchangeRateDerivations(
+				context.Background(),
+				&types.QueryExchangeRateDerivationsRequest{
+					Denom: denom,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExchangeRateOverrides implements the query rate overrides
+// command.
+func GetCmdQueryExchangeRateOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-overrides [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the exchange rates pinned by governance",
+		Long: strings.TrimSpace(`
+Query the exchange rate overrides set by governance, their expiry heights and
+whether the pinned rates are currently in use.
+
+$ umeed query oracle exchange-rate-overrides
+
+Or, you can filter with denom
+
+$ umeed query oracle exchange-rate-overrides ATOM
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var denom string
+			if len(args) > 0 {
+				denom = args[0]
+			}
+
+			res, err := queryClient.ExchangeRateOverrides(
+				context.Background(),
+				&types.QueryExchangeRateOverridesRequest{
+					Denom: denom,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRewardPools implements the query reward pools command.
+func GetCmdQueryRewardPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pools [denom]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the remaining oracle reward pools and their payout per vote period",
+		Long: strings.TrimSpace(`
+Query the remaining balances of the oracle reward pools, the ballots whose
+winners share them and the projected payout of the current vote period.
+
+$ umeed query oracle reward-pools
+
+Or, you can filter with base denom
+
+$ umeed query oracle reward-pools uumee
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var denom string
+			if len(args) > 0 {
+				denom = args[0]
+			}
+
+			res, err := queryClient.RewardPools(
+				context.Background(),
+				&types.QueryRewardPoolsRequest{
+					Denom: denom,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryFeederDelegation implements the query feeder delegation command.
 func GetCmdQueryFeederDelegation() *cobra.Command {
 	cmd := &cobra.Command{
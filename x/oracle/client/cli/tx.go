@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/umee-network/umee/x/oracle/types"
@@ -61,3 +63,66 @@ func GetCmdDelegateFeedConsent() *cobra.Command {
 
 	return cmd
 }
+
+// NewCmdSubmitExchangeRateOverrideProposal returns a CLI command handler to
+// generate or broadcast a transaction with a governance proposal message
+// containing an ExchangeRateOverrideProposal.
+func NewCmdSubmitExchangeRateOverrideProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-override [proposal-file] [deposit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit an oracle exchange rate override governance proposal",
+		Long: strings.TrimSpace(
+			`Submit an oracle exchange rate override proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The exchange rate of the denom
+is pinned for the given number of blocks, but only while the denom has no valid tally.
+
+Example:
+$ umeed tx gov submit-proposal exchange-rate-override </path/to/proposal.json> <deposit> [flags...]
+
+Where proposal.json contains:
+
+{
+  "title": "Pin the ATOM Exchange Rate",
+  "description": "Pin the exchange rate of ATOM while price feeds are down.",
+  "denom": "ATOM",
+  "exchange_rate": "25.5",
+  "duration": "1000"
+}
+`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseExchangeRateOverrideProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewExchangeRateOverrideProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.Denom,
+				proposal.ExchangeRate,
+				proposal.Duration,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/umee-network/umee/x/oracle/client/cli"
+)
+
+// ProposalHandler defines an x/gov proposal handler for the CLI only.
+var ProposalHandler = govclient.NewProposalHandler(
+	cli.NewCmdSubmitExchangeRateOverrideProposal,
+	exchangeRateOverrideProposalNoOpHandler,
+)
+
+func exchangeRateOverrideProposalNoOpHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "exchange_rate_override",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "unsupported route")
+		},
+	}
+}
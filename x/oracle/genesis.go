@@ -37,6 +37,18 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 		}
 
 		keeper.SetMissCounter(ctx, operator, mc.MissCounter)
+		if mc.OutOfBandCounter > 0 {
+			keeper.SetOutOfBandCounter(ctx, operator, mc.OutOfBandCounter)
+		}
+	}
+
+	for _, oc := range genState.OffenseCounters {
+		operator, err := sdk.ValAddressFromBech32(oc.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetOffenseCounter(ctx, operator, oc.OffenseCounter)
 	}
 
 	for _, ap := range genState.AggregateExchangeRatePrevotes {
@@ -57,6 +69,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 		keeper.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, override := range genState.ExchangeRateOverrides {
+		keeper.SetExchangeRateOverride(ctx, override)
+	}
+
 	keeper.SetParams(ctx, genState.Params)
 
 	// check if the module account exists
@@ -95,6 +111,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		missCounters = append(missCounters, types.MissCounter{
 			ValidatorAddress: operator.String(),
 			MissCounter:      missCounter,
+			OutOfBandCounter: keeper.GetOutOfBandCounter(ctx, operator),
+		})
+
+		return false
+	})
+
+	offenseCounters := []types.OffenseCounter{}
+	keeper.IterateOffenseCounters(ctx, func(operator sdk.ValAddress, offenseCounter uint64) (stop bool) {
+		offenseCounters = append(offenseCounters, types.OffenseCounter{
+			ValidatorAddress: operator.String(),
+			OffenseCounter:   offenseCounter,
 		})
 
 		return false
@@ -118,6 +145,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		},
 	)
 
+	exchangeRateOverrides := keeper.GetExchangeRateOverrides(ctx)
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
@@ -125,5 +154,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		missCounters,
 		aggregateExchangeRatePrevotes,
 		aggregateExchangeRateVotes,
+		offenseCounters,
+		exchangeRateOverrides,
 	)
 }
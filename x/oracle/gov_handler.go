@@ -0,0 +1,30 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/umee-network/umee/x/oracle/keeper"
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+func NewExchangeRateOverrideProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ExchangeRateOverrideProposal:
+			return handleExchangeRateOverrideProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized proposal content type: %T", c)
+		}
+	}
+}
+
+func handleExchangeRateOverrideProposal(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	p *types.ExchangeRateOverrideProposal,
+) error {
+	return k.OverrideExchangeRate(ctx, p.Denom, p.ExchangeRate, p.Duration)
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	leveragetypes "github.com/umee-network/umee/x/leverage/types"
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+// AcceptDenom adds the denom to the accept list. If a denom with the same base
+// denom is already accepted, it is only marked as required again.
+func (k Keeper) AcceptDenom(ctx sdk.Context, denom types.Denom) {
+	acceptList := k.AcceptList(ctx)
+
+	if d, i, ok := acceptList.GetByBase(denom.BaseDenom); ok {
+		if !d.Optional {
+			return
+		}
+
+		acceptList[i].Optional = false
+	} else {
+		acceptList = append(acceptList, denom)
+	}
+
+	k.SetAcceptList(ctx, acceptList)
+}
+
+// DelistDenom removes the denom with the given base denom from the accept list
+// if RemoveDelistedDenoms is set, along with all cross pairs it is part of.
+// Otherwise, the denom is kept and marked as optional, so that validators no
+// longer miss votes by skipping it.
+func (k Keeper) DelistDenom(ctx sdk.Context, baseDenom string) {
+	acceptList := k.AcceptList(ctx)
+
+	d, i, ok := acceptList.GetByBase(baseDenom)
+	if !ok {
+		return
+	}
+
+	if !k.RemoveDelistedDenoms(ctx) {
+		acceptList[i].Optional = true
+		k.SetAcceptList(ctx, acceptList)
+		return
+	}
+
+	// cross pairs can only be derived from accepted denoms, so the base of a
+	// pair quoted in the removed denom is voted on against USD again
+	var crossPairList types.CrossPairList
+	for _, cp := range k.CrossPairList(ctx) {
+		if !strings.EqualFold(cp.Base, d.SymbolDenom) && !strings.EqualFold(cp.Quote, d.SymbolDenom) {
+			crossPairList = append(crossPairList, cp)
+		}
+	}
+
+	k.SetCrossPairList(ctx, crossPairList)
+	k.SetAcceptList(ctx, append(acceptList[:i], acceptList[i+1:]...))
+}
+
+// ReconcileAcceptList brings the accept list in line with the x/leverage Token
+// registry. Registered tokens are accepted, whereas denoms that are not part
+// of the registry are delisted.
+func (k Keeper) ReconcileAcceptList(ctx sdk.Context, registry []leveragetypes.Token) {
+	registered := make(map[string]bool, len(registry))
+	for _, token := range registry {
+		registered[token.BaseDenom] = true
+
+		k.AcceptDenom(ctx, types.Denom{
+			BaseDenom:   token.BaseDenom,
+			SymbolDenom: token.SymbolDenom,
+			Exponent:    token.Exponent,
+		})
+	}
+
+	for _, d := range k.AcceptList(ctx) {
+		if !registered[d.BaseDenom] {
+			k.DelistDenom(ctx, d.BaseDenom)
+		}
+	}
+}
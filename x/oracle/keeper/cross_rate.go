@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+// SetCrossExchangeRates derives the USD exchange rate of the base of every
+// tallied cross pair by chaining the tallied cross rate through the USD
+// exchange rate of the pair's quote. Quotes may themselves be derived from
+// another cross pair, in which case their derivation path is extended.
+//
+// A quote that is the base of a pending cross pair is only used once its rate
+// is derived, even if it was also tallied against USD. A quote without a
+// tallied rate is taken at the rate pinned by governance, if it is overridden.
+// Cross pairs whose quote has no USD exchange rate in the current vote period
+// are dropped. Every derived exchange rate is set to the store with its
+// derivation path and an ABCI event.
+func (k Keeper) SetCrossExchangeRates(
+	ctx sdk.Context,
+	crossPairs types.CrossPairList,
+	crossRates map[string]sdk.Dec,
+) {
+	// keep the param order so that rates are derived deterministically
+	pending := types.CrossPairList{}
+	pendingBases := make(map[string]bool)
+	for _, cp := range crossPairs {
+		if _, ok := crossRates[cp.Denom()]; ok {
+			pending = append(pending, cp)
+			pendingBases[strings.ToUpper(cp.Base)] = true
+		}
+	}
+
+	for len(pending) > 0 {
+		unresolved := types.CrossPairList{}
+		for _, cp := range pending {
+			if pendingBases[strings.ToUpper(cp.Quote)] {
+				unresolved = append(unresolved, cp)
+				continue
+			}
+
+			quoteRate, err := k.GetExchangeRate(ctx, cp.Quote)
+			if err != nil {
+				// the override of the quote is only applied once all rates are
+				// derived, as the quote has no tallied rate
+				pinnedRate, ok := k.pinnedExchangeRate(ctx, cp.Quote)
+				if !ok {
+					unresolved = append(unresolved, cp)
+					continue
+				}
+				quoteRate = pinnedRate
+			}
+
+			crossRate := crossRates[cp.Denom()]
+			path := types.ExchangeRateTuples{types.NewExchangeRateTuple(cp.Denom(), crossRate)}
+			if quoteDerivation, err := k.GetExchangeRateDerivation(ctx, cp.Quote); err == nil {
+				path = append(path, quoteDerivation.Path...)
+			} else {
+				path = append(path, types.NewExchangeRateTuple(types.CrossPairDenom(cp.Quote, types.USDDenom), quoteRate))
+			}
+
+			derivation := types.ExchangeRateDerivation{
+				Denom: cp.Base,
+				Path:  path,
+			}
+
+			k.SetExchangeRateWithEvent(ctx, cp.Base, crossRate.Mul(quoteRate))
+			delete(pendingBases, strings.ToUpper(cp.Base))
+			k.SetExchangeRateDerivation(ctx, derivation)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeExchangeRateDerivation,
+					sdk.NewAttribute(types.EventAttrKeyDenom, cp.Base),
+					sdk.NewAttribute(types.EventAttrKeyDerivation, derivation.PathString()),
+				),
+			)
+		}
+
+		// stop once no further pair can be resolved to USD
+		if len(unresolved) == len(pending) {
+			return
+		}
+
+		pending = unresolved
+	}
+}
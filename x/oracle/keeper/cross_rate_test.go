@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) TestSetCrossExchangeRates() {
+	app, ctx := s.app, s.ctx
+
+	crossPairs := types.CrossPairList{
+		{Base: "STATOM", Quote: "ATOM"},
+		{Base: "ATOM", Quote: "OSMO"},
+		{Base: "JUNO", Quote: "SCRT"},
+	}
+	crossRates := map[string]sdk.Dec{
+		"STATOM/ATOM": sdk.MustNewDecFromStr("1.1"),
+		"ATOM/OSMO":   sdk.MustNewDecFromStr("4"),
+		"JUNO/SCRT":   sdk.MustNewDecFromStr("2"),
+	}
+
+	// OSMO is tallied against USD, SCRT is not tallied at all. ATOM is also
+	// tallied against USD, but its derived rate takes precedence.
+	app.OracleKeeper.SetExchangeRate(ctx, "OSMO", sdk.MustNewDecFromStr("7.5"))
+	app.OracleKeeper.SetExchangeRate(ctx, "ATOM", sdk.MustNewDecFromStr("20"))
+	app.OracleKeeper.SetCrossExchangeRates(ctx, crossPairs, crossRates)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("30"), rate)
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, "STATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("33"), rate)
+
+	derivation, err := app.OracleKeeper.GetExchangeRateDerivation(ctx, "statom")
+	s.Require().NoError(err)
+	s.Require().Equal("STATOM", derivation.Denom)
+	s.Require().Equal("STATOM/ATOM,ATOM/OSMO,OSMO/USD", derivation.PathString())
+
+	_, err = app.OracleKeeper.GetExchangeRate(ctx, "JUNO")
+	s.Require().Error(err)
+	_, err = app.OracleKeeper.GetExchangeRateDerivation(ctx, "JUNO")
+	s.Require().Error(err)
+
+	res, err := s.queryClient.ExchangeRateDerivations(ctx.Context(), &types.QueryExchangeRateDerivationsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Derivations, 2)
+}
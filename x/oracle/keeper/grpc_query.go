@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -66,7 +67,19 @@ func (q querier) ExchangeRates(
 		})
 	}
 
-	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
+	// flag the exchange rates pinned by governance
+	overriddenDenoms := []string{}
+	q.IterateExchangeRateOverrides(ctx, func(override types.ExchangeRateOverride) (stop bool) {
+		if override.Applied && (len(req.Denom) == 0 || strings.EqualFold(req.Denom, override.Denom)) {
+			overriddenDenoms = append(overriddenDenoms, override.Denom)
+		}
+		return false
+	})
+
+	return &types.QueryExchangeRatesResponse{
+		ExchangeRates:    exchangeRates,
+		OverriddenDenoms: overriddenDenoms,
+	}, nil
 }
 
 // ActiveExchangeRates queries all denoms for which exchange rates exist.
@@ -89,6 +102,96 @@ func (q querier) ActiveExchangeRates(
 	return &types.QueryActiveExchangeRatesResponse{ActiveRates: denoms}, nil
 }
 
+// ExchangeRateDerivations queries the derivation paths of all exchange rates
+// derived from cross pairs, or, if specified, returns a single denom.
+func (q querier) ExchangeRateDerivations(
+	goCtx context.Context,
+	req *types.QueryExchangeRateDerivationsRequest,
+) (*types.QueryExchangeRateDerivationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	derivations := []types.ExchangeRateDerivation{}
+
+	if len(req.Denom) > 0 {
+		derivation, err := q.GetExchangeRateDerivation(ctx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		derivations = append(derivations, derivation)
+	} else {
+		q.IterateExchangeRateDerivations(ctx, func(derivation types.ExchangeRateDerivation) (stop bool) {
+			derivations = append(derivations, derivation)
+			return false
+		})
+	}
+
+	return &types.QueryExchangeRateDerivationsResponse{Derivations: derivations}, nil
+}
+
+// ExchangeRateOverrides queries the exchange rate overrides set by governance
+// of all denoms, or, if specified, returns a single denom.
+func (q querier) ExchangeRateOverrides(
+	goCtx context.Context,
+	req *types.QueryExchangeRateOverridesRequest,
+) (*types.QueryExchangeRateOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var overrides []types.ExchangeRateOverride
+
+	if len(req.Denom) > 0 {
+		override, err := q.GetExchangeRateOverride(ctx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		overrides = append(overrides, override)
+	} else {
+		overrides = q.GetExchangeRateOverrides(ctx)
+	}
+
+	return &types.QueryExchangeRateOverridesResponse{Overrides: overrides}, nil
+}
+
+// RewardPools queries the reward pools of all denoms, or, if specified,
+// returns a single denom.
+func (q querier) RewardPools(
+	goCtx context.Context,
+	req *types.QueryRewardPoolsRequest,
+) (*types.QueryRewardPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var rewardPools []types.RewardPool
+
+	if len(req.Denom) > 0 {
+		params := q.GetParams(ctx)
+		ballotDenom, _ := params.RewardBallotDenom(req.Denom)
+
+		rewardPools = append(rewardPools, types.NewRewardPool(
+			q.GetRewardPool(ctx, req.Denom),
+			ballotDenom,
+			params.VotePeriod,
+			params.RewardDistributionWindow,
+		))
+	} else {
+		rewardPools = q.GetRewardPools(ctx)
+	}
+
+	return &types.QueryRewardPoolsResponse{RewardPools: rewardPools}, nil
+}
+
 // FeederDelegation queries the account address to which the validator operator
 // delegated oracle vote rights.
 func (q querier) FeederDelegation(
@@ -132,7 +235,9 @@ func (q querier) MissCounter(
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	return &types.QueryMissCounterResponse{
-		MissCounter: q.GetMissCounter(ctx, valAddr),
+		MissCounter:      q.GetMissCounter(ctx, valAddr),
+		OutOfBandCounter: q.GetOutOfBandCounter(ctx, valAddr),
+		OffenseCounter:   q.GetOffenseCounter(ctx, valAddr),
 	}, nil
 }
 
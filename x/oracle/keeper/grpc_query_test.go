@@ -35,6 +35,40 @@ func (s *IntegrationTestSuite) TestQuerier_ExchangeRates() {
 	}, res.ExchangeRates)
 }
 
+func (s *IntegrationTestSuite) TestQuerier_ExchangeRateOverrides() {
+	s.app.OracleKeeper.SetAcceptList(s.ctx, types.DenomList{
+		{BaseDenom: "uatom", SymbolDenom: "ATOM", Exponent: 6},
+	})
+	s.Require().NoError(s.app.OracleKeeper.OverrideExchangeRate(s.ctx, "ATOM", sdk.NewDec(25), 100))
+
+	expected := types.ExchangeRateOverride{
+		Denom:        "ATOM",
+		ExchangeRate: sdk.NewDec(25),
+		ExpiryHeight: s.ctx.BlockHeight() + 100,
+		Applied:      true,
+	}
+
+	res, err := s.queryClient.ExchangeRateOverrides(context.Background(), &types.QueryExchangeRateOverridesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ExchangeRateOverride{expected}, res.Overrides)
+
+	res, err = s.queryClient.ExchangeRateOverrides(context.Background(), &types.QueryExchangeRateOverridesRequest{
+		Denom: "atom",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ExchangeRateOverride{expected}, res.Overrides)
+
+	_, err = s.queryClient.ExchangeRateOverrides(context.Background(), &types.QueryExchangeRateOverridesRequest{
+		Denom: "FOO",
+	})
+	s.Require().Error(err)
+
+	// pinned exchange rates are flagged
+	ratesRes, err := s.queryClient.ExchangeRates(context.Background(), &types.QueryExchangeRatesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"ATOM"}, ratesRes.OverriddenDenoms)
+}
+
 func (s *IntegrationTestSuite) TestQuerier_FeeederDelegation() {
 	feederAddr := sdk.AccAddress([]byte("addr________________"))
 	feederAcc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, feederAddr)
@@ -142,3 +176,24 @@ func (s *IntegrationTestSuite) TestQuerier_Params() {
 	s.Require().NoError(err)
 	s.Require().Equal(types.DefaultGenesisState().Params, res.Params)
 }
+
+func (s *IntegrationTestSuite) TestQuerier_RewardPools() {
+	givingAmt := sdk.NewCoins(sdk.NewInt64Coin(types.UmeeDenom, 30000000))
+	err := s.app.BankKeeper.MintCoins(s.ctx, "leverage", givingAmt)
+	s.Require().NoError(err)
+	err = s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, "leverage", "oracle", givingAmt)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.RewardPools(context.Background(), &types.QueryRewardPoolsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.RewardPools, 1)
+	s.Require().Equal(givingAmt[0], res.RewardPools[0].Balance)
+
+	res, err = s.queryClient.RewardPools(context.Background(), &types.QueryRewardPoolsRequest{
+		Denom: "uatom",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.RewardPools, 1)
+	s.Require().True(res.RewardPools[0].Balance.IsZero())
+	s.Require().True(res.RewardPools[0].PeriodReward.IsZero())
+}
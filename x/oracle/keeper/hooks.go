@@ -21,37 +21,21 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // AfterTokenRegistered implements the x/leverage Hooks interface. Specifically,
-// it checks if the provided Token should be added to the existing accepted list
-// of assets for the x/oracle module.
+// it adds the provided Token to the accepted list of assets for the x/oracle
+// module, or marks it as required again if it was optional.
 func (h Hooks) AfterTokenRegistered(ctx sdk.Context, token leveragetypes.Token) {
-	acceptList := h.k.AcceptList(ctx)
-
-	var tokenExists bool
-	for _, t := range acceptList {
-		if t.BaseDenom == token.BaseDenom {
-			tokenExists = true
-			break
-		}
-	}
-
-	if !tokenExists {
-		acceptList = append(acceptList, types.Denom{
-			BaseDenom:   token.BaseDenom,
-			SymbolDenom: token.SymbolDenom,
-			Exponent:    token.Exponent,
-		})
-	}
-
-	h.k.SetAcceptList(ctx, acceptList)
+	h.k.AcceptDenom(ctx, types.Denom{
+		BaseDenom:   token.BaseDenom,
+		SymbolDenom: token.SymbolDenom,
+		Exponent:    token.Exponent,
+	})
 }
 
-// AfterRegisteredTokenRemoved implements the x/leverage Hooks interface.
-// Currently, it performs a no-op, however, we may want to remove tokens from
-// the accept list in the future when they're removed from the x/leverage Token
-// registry.
-//
-// We don't remove the token from the accept list because it might prove to be
-// useful to still have price data for assets outside of the scope of the
-// x/leverage registry. If assets need to be removed, they can always be purged
-// via param change proposals.
-func (h Hooks) AfterRegisteredTokenRemoved(ctx sdk.Context, token leveragetypes.Token) {}
+// AfterRegisteredTokenRemoved implements the x/leverage Hooks interface. The
+// delisted Token is either removed from the accept list or kept as an optional
+// denom, as governed by the RemoveDelistedDenoms parameter. Keeping it allows
+// validators to still provide price data for assets outside of the scope of
+// the x/leverage registry without being penalized for skipping it.
+func (h Hooks) AfterRegisteredTokenRemoved(ctx sdk.Context, token leveragetypes.Token) {
+	h.k.DelistDenom(ctx, token.BaseDenom)
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	umeeapp "github.com/umee-network/umee/app"
 	leveragetypes "github.com/umee-network/umee/x/leverage/types"
+	"github.com/umee-network/umee/x/oracle/types"
 )
 
 func (s *IntegrationTestSuite) TestHooks_AfterTokenRegistered() {
@@ -25,3 +26,46 @@ func (s *IntegrationTestSuite) TestHooks_AfterTokenRegistered() {
 	})
 	s.Require().Len(s.app.OracleKeeper.AcceptList(s.ctx), 2)
 }
+
+func (s *IntegrationTestSuite) TestHooks_AfterRegisteredTokenRemoved() {
+	h := s.app.OracleKeeper.Hooks()
+	atom := leveragetypes.Token{
+		BaseDenom:   "ibc/CDC4587874B85BEA4FCEC3CEA5A1195139799A1FEE711A07D972537E18FDA39D",
+		SymbolDenom: "ATOM",
+		Exponent:    6,
+	}
+	stAtom := leveragetypes.Token{
+		BaseDenom:   "ibc/C1B34D8EDE8A12BD7E9E17A1D8F6C7D4D25B7B5C5A8E8F4A6F7A4A0B1C2D3E4F",
+		SymbolDenom: "STATOM",
+		Exponent:    6,
+	}
+	h.AfterTokenRegistered(s.ctx, atom)
+	h.AfterTokenRegistered(s.ctx, stAtom)
+	s.app.OracleKeeper.SetCrossPairList(s.ctx, types.CrossPairList{{Base: "STATOM", Quote: "ATOM"}})
+
+	// require a delisted token to be marked optional by default
+	h.AfterRegisteredTokenRemoved(s.ctx, stAtom)
+	acceptList := s.app.OracleKeeper.AcceptList(s.ctx)
+	s.Require().Len(acceptList, 3)
+	s.Require().True(acceptList[2].Optional)
+	s.Require().Len(s.app.OracleKeeper.CrossPairList(s.ctx), 1)
+	s.Require().Equal([]string{"umee", "ATOM"}, s.app.OracleKeeper.GetParams(s.ctx).VoteTargets())
+
+	// require a registered token to be required again
+	h.AfterTokenRegistered(s.ctx, stAtom)
+	acceptList = s.app.OracleKeeper.AcceptList(s.ctx)
+	s.Require().Len(acceptList, 3)
+	s.Require().False(acceptList[2].Optional)
+
+	// require a delisted token and its cross pairs to be removed if set
+	params := s.app.OracleKeeper.GetParams(s.ctx)
+	params.RemoveDelistedDenoms = true
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	h.AfterRegisteredTokenRemoved(s.ctx, atom)
+	acceptList = s.app.OracleKeeper.AcceptList(s.ctx)
+	s.Require().Len(acceptList, 2)
+	s.Require().Equal(stAtom.BaseDenom, acceptList[1].BaseDenom)
+	s.Require().Empty(s.app.OracleKeeper.CrossPairList(s.ctx))
+	s.Require().NoError(s.app.OracleKeeper.GetParams(s.ctx).Validate())
+}
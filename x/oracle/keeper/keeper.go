@@ -23,10 +23,11 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	paramSpace paramstypes.Subspace
 
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
-	distrKeeper   types.DistributionKeeper
-	StakingKeeper types.StakingKeeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistributionKeeper
+	StakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
 
 	distrName string
 }
@@ -40,6 +41,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistributionKeeper,
 	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
 	distrName string,
 ) Keeper {
 
@@ -54,14 +56,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramSpace:    paramspace,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		distrKeeper:   distrKeeper,
-		StakingKeeper: stakingKeeper,
-		distrName:     distrName,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		paramSpace:     paramspace,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+		StakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		distrName:      distrName,
 	}
 }
 
@@ -165,6 +168,59 @@ func (k Keeper) IterateExchangeRates(ctx sdk.Context, handler func(string, sdk.D
 	}
 }
 
+// GetExchangeRateDerivation gets the derivation path of an exchange rate
+// derived from a cross pair from the store.
+func (k Keeper) GetExchangeRateDerivation(ctx sdk.Context, symbol string) (types.ExchangeRateDerivation, error) {
+	store := ctx.KVStore(k.storeKey)
+	symbol = strings.ToUpper(symbol)
+	bz := store.Get(types.GetExchangeRateDerivationKey(symbol))
+	if bz == nil {
+		return types.ExchangeRateDerivation{}, sdkerrors.Wrap(types.ErrUnknownDenom, symbol)
+	}
+
+	var derivation types.ExchangeRateDerivation
+	k.cdc.MustUnmarshal(bz, &derivation)
+
+	return derivation, nil
+}
+
+// SetExchangeRateDerivation sets the derivation path of an exchange rate
+// derived from a cross pair to the store.
+func (k Keeper) SetExchangeRateDerivation(ctx sdk.Context, derivation types.ExchangeRateDerivation) {
+	store := ctx.KVStore(k.storeKey)
+	derivation.Denom = strings.ToUpper(derivation.Denom)
+	bz := k.cdc.MustMarshal(&derivation)
+	store.Set(types.GetExchangeRateDerivationKey(derivation.Denom), bz)
+}
+
+// DeleteExchangeRateDerivation deletes the derivation path of an exchange
+// rate from the store.
+func (k Keeper) DeleteExchangeRateDerivation(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetExchangeRateDerivationKey(denom))
+}
+
+// IterateExchangeRateDerivations iterates over the exchange rate derivations
+// in the store.
+func (k Keeper) IterateExchangeRateDerivations(
+	ctx sdk.Context,
+	handler func(types.ExchangeRateDerivation) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixExchangeRateDerivation)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var derivation types.ExchangeRateDerivation
+		k.cdc.MustUnmarshal(iter.Value(), &derivation)
+
+		if handler(derivation) {
+			break
+		}
+	}
+}
+
 // GetFeederDelegation gets the account address to which the validator operator
 // delegated oracle vote rights.
 func (k Keeper) GetFeederDelegation(ctx sdk.Context, operator sdk.ValAddress) (sdk.AccAddress, error) {
@@ -261,6 +317,88 @@ func (k Keeper) IterateMissCounters(ctx sdk.Context, handler func(sdk.ValAddress
 	}
 }
 
+// GetOutOfBandCounter retrieves the # of vote periods missed in this oracle
+// slash window because of votes outside of the reward band.
+func (k Keeper) GetOutOfBandCounter(ctx sdk.Context, operator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOutOfBandCounterKey(operator))
+	if bz == nil {
+		// by default the counter is zero
+		return 0
+	}
+
+	var outOfBandCounter gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &outOfBandCounter)
+
+	return outOfBandCounter.Value
+}
+
+// SetOutOfBandCounter updates the # of vote periods missed in this oracle
+// slash window because of votes outside of the reward band.
+func (k Keeper) SetOutOfBandCounter(ctx sdk.Context, operator sdk.ValAddress, outOfBandCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: outOfBandCounter})
+	store.Set(types.GetOutOfBandCounterKey(operator), bz)
+}
+
+// DeleteOutOfBandCounter removes the out of band counter for the validator.
+func (k Keeper) DeleteOutOfBandCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOutOfBandCounterKey(operator))
+}
+
+// GetOffenseCounter retrieves the # of oracle slash windows in which the
+// validator fell below the minimum valid vote rate and has not yet worked off.
+func (k Keeper) GetOffenseCounter(ctx sdk.Context, operator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOffenseCounterKey(operator))
+	if bz == nil {
+		// by default the counter is zero
+		return 0
+	}
+
+	var offenseCounter gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &offenseCounter)
+
+	return offenseCounter.Value
+}
+
+// SetOffenseCounter updates the # of oracle slash windows in which the
+// validator fell below the minimum valid vote rate.
+func (k Keeper) SetOffenseCounter(ctx sdk.Context, operator sdk.ValAddress, offenseCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: offenseCounter})
+	store.Set(types.GetOffenseCounterKey(operator), bz)
+}
+
+// DeleteOffenseCounter removes the offense counter for the validator.
+func (k Keeper) DeleteOffenseCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOffenseCounterKey(operator))
+}
+
+// IterateOffenseCounters iterates over the offense counters and performs a
+// callback function.
+func (k Keeper) IterateOffenseCounters(ctx sdk.Context, handler func(sdk.ValAddress, uint64) bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixOffenseCounter)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var offenseCounter gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &offenseCounter)
+
+		if handler(operator, offenseCounter.Value) {
+			break
+		}
+	}
+}
+
 // GetAggregateExchangeRatePrevote retrieves an oracle prevote from the store.
 func (k Keeper) GetAggregateExchangeRatePrevote(
 	ctx sdk.Context,
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	leverageKeeper types.LeverageKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, leverageKeeper types.LeverageKeeper) Migrator {
+	return Migrator{
+		keeper:         keeper,
+		leverageKeeper: leverageKeeper,
+	}
+}
+
+// Migrate1to2 migrates the x/oracle module state from consensus version 1 to
+// version 2. It sets the parameters introduced in version 2 to their defaults
+// and reconciles the accept list with the x/leverage Token registry, since
+// tokens delisted before version 2 were never removed from it.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	newParams := []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyCrossPairList, defaults.CrossPairList},
+		{types.KeySlashEscalationRate, defaults.SlashEscalationRate},
+		{types.KeyMaxSlashFraction, defaults.MaxSlashFraction},
+		{types.KeyRemoveDelistedDenoms, defaults.RemoveDelistedDenoms},
+	}
+
+	for _, p := range newParams {
+		if !m.keeper.paramSpace.Has(ctx, p.key) {
+			m.keeper.paramSpace.Set(ctx, p.key, p.value)
+		}
+	}
+
+	m.keeper.ReconcileAcceptList(ctx, m.leverageKeeper.GetAllRegisteredTokens(ctx))
+	return nil
+}
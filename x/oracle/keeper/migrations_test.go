@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	leveragetypes "github.com/umee-network/umee/x/leverage/types"
+	"github.com/umee-network/umee/x/oracle/keeper"
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+type mockLeverageKeeper struct {
+	registry []leveragetypes.Token
+}
+
+func (m mockLeverageKeeper) GetAllRegisteredTokens(_ sdk.Context) []leveragetypes.Token {
+	return m.registry
+}
+
+func (s *IntegrationTestSuite) TestMigrator_Migrate1to2() {
+	acceptList := s.app.OracleKeeper.AcceptList(s.ctx)
+	acceptList = append(acceptList, types.Denom{BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6})
+	s.app.OracleKeeper.SetAcceptList(s.ctx, acceptList)
+
+	m := keeper.NewMigrator(s.app.OracleKeeper, mockLeverageKeeper{
+		registry: []leveragetypes.Token{
+			{BaseDenom: types.UmeeDenom, SymbolDenom: types.UmeeSymbol, Exponent: types.UmeeExponent},
+			{BaseDenom: "uatom", SymbolDenom: "ATOM", Exponent: 6},
+		},
+	})
+	s.Require().NoError(m.Migrate1to2(s.ctx))
+
+	s.Require().Equal(types.DenomList{
+		{BaseDenom: types.UmeeDenom, SymbolDenom: types.UmeeSymbol, Exponent: types.UmeeExponent},
+		{BaseDenom: "uosmo", SymbolDenom: "OSMO", Exponent: 6, Optional: true},
+		{BaseDenom: "uatom", SymbolDenom: "ATOM", Exponent: 6},
+	}, s.app.OracleKeeper.AcceptList(s.ctx))
+	s.Require().NoError(s.app.OracleKeeper.GetParams(s.ctx).Validate())
+}
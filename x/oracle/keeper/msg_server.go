@@ -113,10 +113,12 @@ func (ms msgServer) AggregateExchangeRateVote(
 		return nil, sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, hash)
 	}
 
-	// Filter out rates which aren't included in the AcceptList
+	// Filter out rates which aren't included in the AcceptList or the
+	// CrossPairList. The USD rates of cross pair bases are kept, they are
+	// accepted in place of the cross pair vote of validators that didn't cast one.
 	filteredTuples := types.ExchangeRateTuples{}
 	for _, tuple := range exchangeRateTuples {
-		if params.AcceptList.Contains(tuple.Denom) {
+		if params.AcceptList.Contains(tuple.Denom) || params.CrossPairList.Contains(tuple.Denom) {
 			filteredTuples = append(filteredTuples, tuple)
 		}
 	}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+// GetExchangeRateOverride gets the exchange rate override of a denom from the
+// store.
+func (k Keeper) GetExchangeRateOverride(ctx sdk.Context, denom string) (types.ExchangeRateOverride, error) {
+	store := ctx.KVStore(k.storeKey)
+	denom = strings.ToUpper(denom)
+	bz := store.Get(types.GetExchangeRateOverrideKey(denom))
+	if bz == nil {
+		return types.ExchangeRateOverride{}, sdkerrors.Wrap(types.ErrUnknownDenom, denom)
+	}
+
+	var override types.ExchangeRateOverride
+	k.cdc.MustUnmarshal(bz, &override)
+
+	return override, nil
+}
+
+// SetExchangeRateOverride sets the exchange rate override of a denom to the
+// store.
+func (k Keeper) SetExchangeRateOverride(ctx sdk.Context, override types.ExchangeRateOverride) {
+	store := ctx.KVStore(k.storeKey)
+	override.Denom = strings.ToUpper(override.Denom)
+	bz := k.cdc.MustMarshal(&override)
+	store.Set(types.GetExchangeRateOverrideKey(override.Denom), bz)
+}
+
+// DeleteExchangeRateOverride deletes the exchange rate override of a denom
+// from the store.
+func (k Keeper) DeleteExchangeRateOverride(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetExchangeRateOverrideKey(strings.ToUpper(denom)))
+}
+
+// IterateExchangeRateOverrides iterates over the exchange rate overrides in
+// the store.
+func (k Keeper) IterateExchangeRateOverrides(
+	ctx sdk.Context,
+	handler func(types.ExchangeRateOverride) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixExchangeRateOverride)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var override types.ExchangeRateOverride
+		k.cdc.MustUnmarshal(iter.Value(), &override)
+
+		if handler(override) {
+			break
+		}
+	}
+}
+
+// GetExchangeRateOverrides returns all exchange rate overrides in the store.
+func (k Keeper) GetExchangeRateOverrides(ctx sdk.Context) []types.ExchangeRateOverride {
+	overrides := []types.ExchangeRateOverride{}
+	k.IterateExchangeRateOverrides(ctx, func(override types.ExchangeRateOverride) (stop bool) {
+		overrides = append(overrides, override)
+		return false
+	})
+
+	return overrides
+}
+
+// OverrideExchangeRate pins the exchange rate of an accepted denom for the
+// given number of blocks. The pinned rate is set right away only if the denom
+// has no exchange rate, or if its current rate is pinned by a previous
+// override. A tallied exchange rate is never replaced.
+func (k Keeper) OverrideExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec, duration uint64) error {
+	denom = strings.ToUpper(denom)
+	if !k.AcceptList(ctx).Contains(denom) {
+		return sdkerrors.Wrap(types.ErrUnknownDenom, denom)
+	}
+
+	override := types.ExchangeRateOverride{
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		ExpiryHeight: ctx.BlockHeight() + int64(duration),
+	}
+
+	prev, err := k.GetExchangeRateOverride(ctx, denom)
+	pinned := err == nil && prev.Applied
+	if _, err := k.GetExchangeRate(ctx, denom); err != nil || pinned {
+		k.SetExchangeRateWithEvent(ctx, denom, exchangeRate)
+		override.Applied = true
+	}
+
+	k.SetExchangeRateOverride(ctx, override)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeExchangeRateOverride,
+			sdk.NewAttribute(types.EventAttrKeyDenom, denom),
+			sdk.NewAttribute(types.EventAttrKeyExchangeRate, exchangeRate.String()),
+			sdk.NewAttribute(types.EventAttrKeyExpiryHeight, fmt.Sprintf("%d", override.ExpiryHeight)),
+		),
+	)
+
+	return nil
+}
+
+// pinnedExchangeRate returns the exchange rate governance pins for an accepted
+// denom at the end of the vote period if it did not get one from the tally.
+func (k Keeper) pinnedExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	override, err := k.GetExchangeRateOverride(ctx, denom)
+	if err != nil || !k.AcceptList(ctx).Contains(override.Denom) {
+		return sdk.Dec{}, false
+	}
+
+	return override.ExchangeRate, true
+}
+
+// ApplyExchangeRateOverrides sets the pinned exchange rate of every overridden
+// denom that did not get an exchange rate from the tally of the current vote
+// period. It must be called once all ballots are tallied.
+func (k Keeper) ApplyExchangeRateOverrides(ctx sdk.Context) {
+	acceptList := k.AcceptList(ctx)
+
+	for _, override := range k.GetExchangeRateOverrides(ctx) {
+		_, err := k.GetExchangeRate(ctx, override.Denom)
+		override.Applied = err != nil && acceptList.Contains(override.Denom)
+		if override.Applied {
+			k.SetExchangeRateWithEvent(ctx, override.Denom, override.ExchangeRate)
+		}
+
+		k.SetExchangeRateOverride(ctx, override)
+	}
+}
+
+// ExpireExchangeRateOverrides deletes every exchange rate override that
+// reached its expiry height, along with the pinned exchange rate if it is in
+// use.
+func (k Keeper) ExpireExchangeRateOverrides(ctx sdk.Context) {
+	for _, override := range k.GetExchangeRateOverrides(ctx) {
+		if !override.IsExpired(ctx.BlockHeight()) {
+			continue
+		}
+
+		k.DeleteExchangeRateOverride(ctx, override.Denom)
+		if override.Applied {
+			k.DeleteExchangeRate(ctx, override.Denom)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeOverrideExpired,
+				sdk.NewAttribute(types.EventAttrKeyDenom, override.Denom),
+				sdk.NewAttribute(types.EventAttrKeyExchangeRate, override.ExchangeRate.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/x/oracle/types"
+)
+
+func (s *IntegrationTestSuite) setOverrideAcceptList() {
+	s.app.OracleKeeper.SetAcceptList(s.ctx, types.DenomList{
+		{BaseDenom: types.UmeeDenom, SymbolDenom: types.UmeeSymbol, Exponent: types.UmeeExponent},
+		{BaseDenom: "uatom", SymbolDenom: "ATOM", Exponent: 6},
+	})
+}
+
+func (s *IntegrationTestSuite) TestOverrideExchangeRate() {
+	app, ctx := s.app, s.ctx
+	s.setOverrideAcceptList()
+
+	// denoms that are not accepted cannot be overridden
+	err := app.OracleKeeper.OverrideExchangeRate(ctx, "FOO", sdk.OneDec(), 100)
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	// the pinned rate is set right away for denoms without an exchange rate
+	err = app.OracleKeeper.OverrideExchangeRate(ctx, "atom", sdk.NewDec(25), 100)
+	s.Require().NoError(err)
+
+	override, err := app.OracleKeeper.GetExchangeRateOverride(ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().True(override.Applied)
+	s.Require().Equal(ctx.BlockHeight()+100, override.ExpiryHeight)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(25), rate)
+
+	// a tallied exchange rate is never replaced
+	app.OracleKeeper.SetExchangeRate(ctx, "UMEE", sdk.OneDec())
+	err = app.OracleKeeper.OverrideExchangeRate(ctx, "UMEE", sdk.NewDec(2), 100)
+	s.Require().NoError(err)
+
+	override, err = app.OracleKeeper.GetExchangeRateOverride(ctx, "UMEE")
+	s.Require().NoError(err)
+	s.Require().False(override.Applied)
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, "UMEE")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	// a rate pinned by a previous override is replaced
+	err = app.OracleKeeper.OverrideExchangeRate(ctx, "ATOM", sdk.NewDec(30), 200)
+	s.Require().NoError(err)
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(30), rate)
+	s.Require().Len(app.OracleKeeper.GetExchangeRateOverrides(ctx), 2)
+}
+
+func (s *IntegrationTestSuite) TestApplyExchangeRateOverrides() {
+	app, ctx := s.app, s.ctx
+	s.setOverrideAcceptList()
+
+	app.OracleKeeper.SetExchangeRateOverride(ctx, types.ExchangeRateOverride{
+		Denom:        "ATOM",
+		ExchangeRate: sdk.NewDec(25),
+		ExpiryHeight: ctx.BlockHeight() + 100,
+		Applied:      true,
+	})
+	app.OracleKeeper.SetExchangeRateOverride(ctx, types.ExchangeRateOverride{
+		Denom:        "UMEE",
+		ExchangeRate: sdk.NewDec(2),
+		ExpiryHeight: ctx.BlockHeight() + 100,
+		Applied:      true,
+	})
+
+	// UMEE has a valid tally, ATOM does not
+	app.OracleKeeper.SetExchangeRate(ctx, "UMEE", sdk.OneDec())
+	app.OracleKeeper.ApplyExchangeRateOverrides(ctx)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(25), rate)
+
+	override, err := app.OracleKeeper.GetExchangeRateOverride(ctx, "ATOM")
+	s.Require().NoError(err)
+	s.Require().True(override.Applied)
+
+	rate, err = app.OracleKeeper.GetExchangeRate(ctx, "UMEE")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+
+	override, err = app.OracleKeeper.GetExchangeRateOverride(ctx, "UMEE")
+	s.Require().NoError(err)
+	s.Require().False(override.Applied)
+}
+
+func (s *IntegrationTestSuite) TestExpireExchangeRateOverrides() {
+	app, ctx := s.app, s.ctx
+	s.setOverrideAcceptList()
+
+	s.Require().NoError(app.OracleKeeper.OverrideExchangeRate(ctx, "ATOM", sdk.NewDec(25), 10))
+	app.OracleKeeper.SetExchangeRate(ctx, "UMEE", sdk.OneDec())
+	s.Require().NoError(app.OracleKeeper.OverrideExchangeRate(ctx, "UMEE", sdk.NewDec(2), 10))
+
+	// overrides remain in effect until their expiry height
+	app.OracleKeeper.ExpireExchangeRateOverrides(ctx.WithBlockHeight(ctx.BlockHeight() + 9))
+	s.Require().Len(app.OracleKeeper.GetExchangeRateOverrides(ctx), 2)
+
+	// the pinned rate is deleted on expiry, the tallied one is kept
+	app.OracleKeeper.ExpireExchangeRateOverrides(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	s.Require().Empty(app.OracleKeeper.GetExchangeRateOverrides(ctx))
+
+	_, err := app.OracleKeeper.GetExchangeRate(ctx, "ATOM")
+	s.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	rate, err := app.OracleKeeper.GetExchangeRate(ctx, "UMEE")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneDec(), rate)
+}
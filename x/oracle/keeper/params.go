@@ -45,12 +45,43 @@ func (k Keeper) SetAcceptList(ctx sdk.Context, acceptList types.DenomList) {
 	k.paramSpace.Set(ctx, types.KeyAcceptList, acceptList)
 }
 
+// CrossPairList returns the pairs that are voted on against a non-USD quote
+func (k Keeper) CrossPairList(ctx sdk.Context) (res types.CrossPairList) {
+	k.paramSpace.Get(ctx, types.KeyCrossPairList, &res)
+	return
+}
+
+// SetCrossPairList updates the pairs that are voted on against a non-USD quote.
+func (k Keeper) SetCrossPairList(ctx sdk.Context, crossPairList types.CrossPairList) {
+	k.paramSpace.Set(ctx, types.KeyCrossPairList, crossPairList)
+}
+
+// RemoveDelistedDenoms returns whether denoms delisted from the x/leverage
+// Token registry are removed from the accept list rather than marked optional
+func (k Keeper) RemoveDelistedDenoms(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyRemoveDelistedDenoms, &res)
+	return
+}
+
 // SlashFraction returns oracle voting penalty rate
 func (k Keeper) SlashFraction(ctx sdk.Context) (res sdk.Dec) {
 	k.paramSpace.Get(ctx, types.KeySlashFraction, &res)
 	return
 }
 
+// SlashEscalationRate returns the rate by which the oracle voting penalty
+// escalates for every repeated offense
+func (k Keeper) SlashEscalationRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySlashEscalationRate, &res)
+	return
+}
+
+// MaxSlashFraction returns the maximum oracle voting penalty rate
+func (k Keeper) MaxSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMaxSlashFraction, &res)
+	return
+}
+
 // SlashWindow returns # of vote period for oracle slashing
 func (k Keeper) SlashWindow(ctx sdk.Context) (res uint64) {
 	k.paramSpace.Get(ctx, types.KeySlashWindow, &res)
@@ -8,65 +8,83 @@ import (
 	"github.com/umee-network/umee/x/oracle/types"
 )
 
-// RewardBallotWinners is executed at the end of every voting period, where we
-// give out a portion of seigniorage reward(reward-weight) to the oracle voters
-// that voted correctly.
-func (k Keeper) RewardBallotWinners(
-	ctx sdk.Context,
-	votePeriod int64,
-	rewardDistributionWindow int64,
-	voteTargets []string,
-	ballotWinners map[string]types.Claim,
-) {
-	rewardDenoms := make([]string, len(voteTargets)+1)
-	rewardDenoms[0] = types.UmeeDenom
-
-	i := 1
-	for _, denom := range voteTargets {
-		rewardDenoms[i] = denom
-		i++
-	}
+// GetRewardPools returns the reward pool of every denom held by the oracle
+// module account, along with the ballot its rewards are attributed to and the
+// projected payout of the current vote period.
+func (k Keeper) GetRewardPools(ctx sdk.Context) []types.RewardPool {
+	params := k.GetParams(ctx)
 
-	// sum weight of the claims
-	var ballotPowerSum int64
-	for _, winner := range ballotWinners {
-		ballotPowerSum += winner.Weight
-	}
+	acc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	balances := k.bankKeeper.GetAllBalances(ctx, acc.GetAddress())
 
-	// return if the ballot is empty
-	if ballotPowerSum == 0 {
-		return
+	rewardPools := make([]types.RewardPool, len(balances))
+	for i, balance := range balances {
+		ballotDenom, _ := params.RewardBallotDenom(balance.Denom)
+		rewardPools[i] = types.NewRewardPool(balance, ballotDenom, params.VotePeriod, params.RewardDistributionWindow)
 	}
 
-	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
+	return rewardPools
+}
+
+// RewardBallotWinners is executed at the end of every voting period, where we
+// give out a portion of every reward pool to the oracle voters that voted
+// correctly. Rewards of a denom in the AcceptList (e.g. interest paid by
+// x/leverage borrowers of that denom) are only shared among the winners of the
+// denom's ballot, weighted by their voting power. Rewards of any other denom
+// are shared among all ballot winners, weighted by their total claim weight.
+func (k Keeper) RewardBallotWinners(ctx sdk.Context, ballotWinners map[string]types.Claim) {
+	claims := types.ClaimMapToSlice(ballotWinners)
+	rewards := make(map[string]sdk.Coins, len(claims))
+
+	for _, rewardPool := range k.GetRewardPools(ctx) {
+		// skip if there's no rewards to give out
+		if rewardPool.PeriodReward.IsZero() {
+			continue
+		}
+
+		claimWeight := func(claim types.Claim) int64 {
+			if len(rewardPool.BallotDenom) == 0 {
+				return claim.Weight
+			}
+			return claim.DenomWeights[rewardPool.BallotDenom]
+		}
 
-	var periodRewards sdk.DecCoins
-	for _, denom := range rewardDenoms {
-		rewardPool := k.GetRewardPool(ctx, denom)
+		// sum weight of the claims
+		var ballotPowerSum int64
+		for _, claim := range claims {
+			ballotPowerSum += claimWeight(claim)
+		}
 
-		// return if there's no rewards to give out
-		if rewardPool.IsZero() {
+		// skip if the ballot has no winners, the rewards remain in the pool
+		if ballotPowerSum == 0 {
 			continue
 		}
 
-		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(
-			denom,
-			sdk.NewDecFromInt(rewardPool.Amount).Mul(distributionRatio),
-		))
+		for _, claim := range claims {
+			// reflects contribution
+			rewardAmount := rewardPool.PeriodReward.Amount.
+				Mul(sdk.NewDec(claimWeight(claim)).QuoInt64(ballotPowerSum)).
+				TruncateInt()
+			if !rewardAmount.IsPositive() {
+				continue
+			}
+
+			key := claim.Recipient.String()
+			rewards[key] = rewards[key].Add(sdk.NewCoin(rewardPool.Balance.Denom, rewardAmount))
+		}
 	}
 
 	// distribute rewards
 	var distributedReward sdk.Coins
-	for _, winner := range ballotWinners {
-		receiverVal := k.StakingKeeper.Validator(ctx, winner.Recipient)
-		// in case absence of the validator, we just skip distribution
-		if receiverVal == nil {
+	for _, claim := range claims {
+		rewardCoins := rewards[claim.Recipient.String()]
+		if rewardCoins.IsZero() {
 			continue
 		}
 
-		// reflects contribution
-		rewardCoins, _ := periodRewards.MulDec(sdk.NewDec(winner.Weight).QuoInt64(ballotPowerSum)).TruncateDecimal()
-		if rewardCoins.IsZero() {
+		receiverVal := k.StakingKeeper.Validator(ctx, claim.Recipient)
+		// in case absence of the validator, we just skip distribution
+		if receiverVal == nil {
 			continue
 		}
 
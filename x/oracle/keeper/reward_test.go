@@ -8,33 +8,71 @@ import (
 
 // Test the reward giving mechanism
 func (s *IntegrationTestSuite) TestRewardBallotWinners() {
-	// Add claim pools
+	params := s.app.OracleKeeper.GetParams(s.ctx)
+	params.AcceptList = append(params.AcceptList, types.Denom{
+		BaseDenom:   "uatom",
+		SymbolDenom: "ATOM",
+		Exponent:    6,
+	})
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	// Add claim pools, only the second validator won the ATOM ballot
 	claims := map[string]types.Claim{
-		valAddr.String():  types.NewClaim(10, 10, 0, valAddr),
-		valAddr2.String(): types.NewClaim(20, 20, 0, valAddr2),
+		valAddr.String():  types.NewClaim(10, 10, 1, valAddr),
+		valAddr2.String(): types.NewClaim(20, 40, 2, valAddr2),
 	}
+	claims[valAddr.String()].DenomWeights["UMEE"] = 10
+	claims[valAddr2.String()].DenomWeights["UMEE"] = 20
+	claims[valAddr2.String()].DenomWeights["ATOM"] = 20
 
-	// Prepare reward pool
-	givingAmt := sdk.NewCoins(sdk.NewInt64Coin(types.UmeeDenom, 30000000))
+	// Prepare reward pools, uother is not attributed to any ballot
+	givingAmt := sdk.NewCoins(
+		sdk.NewInt64Coin(types.UmeeDenom, 30000000),
+		sdk.NewInt64Coin("uatom", 30000000),
+		sdk.NewInt64Coin("uother", 50000000),
+	)
 	err := s.app.BankKeeper.MintCoins(s.ctx, "leverage", givingAmt)
 	s.Require().NoError(err)
 	err = s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, "leverage", "oracle", givingAmt)
 	s.Require().NoError(err)
 
-	var voteTargets []string
-	var voteTargetDenoms []string
-	params := s.app.OracleKeeper.GetParams(s.ctx)
-	for _, v := range params.AcceptList {
-		voteTargets = append(voteTargets, v.SymbolDenom)
-		voteTargetDenoms = append(voteTargetDenoms, v.BaseDenom)
-	}
-
 	votePeriodsPerWindow := sdk.NewDec((int64)(s.app.OracleKeeper.RewardDistributionWindow(s.ctx))).
 		QuoInt64((int64)(s.app.OracleKeeper.VotePeriod(s.ctx))).
 		TruncateInt64()
-	s.app.OracleKeeper.RewardBallotWinners(s.ctx, (int64)(s.app.OracleKeeper.VotePeriod(s.ctx)), (int64)(s.app.OracleKeeper.RewardDistributionWindow(s.ctx)), voteTargets, claims)
+	s.app.OracleKeeper.RewardBallotWinners(s.ctx, claims)
+
 	outstandingRewardsDec := s.app.DistrKeeper.GetValidatorOutstandingRewardsCoins(s.ctx, valAddr)
 	outstandingRewards, _ := outstandingRewardsDec.TruncateDecimal()
 	s.Require().Equal(sdk.NewDecFromInt(givingAmt.AmountOf(types.UmeeDenom)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).TruncateInt(),
 		outstandingRewards.AmountOf(types.UmeeDenom))
+	s.Require().True(outstandingRewards.AmountOf("uatom").IsZero())
+	s.Require().Equal(sdk.NewDecFromInt(givingAmt.AmountOf("uother")).QuoInt64(votePeriodsPerWindow).QuoInt64(5).TruncateInt(),
+		outstandingRewards.AmountOf("uother"))
+
+	outstandingRewardsDec = s.app.DistrKeeper.GetValidatorOutstandingRewardsCoins(s.ctx, valAddr2)
+	outstandingRewards, _ = outstandingRewardsDec.TruncateDecimal()
+	s.Require().Equal(sdk.NewDecFromInt(givingAmt.AmountOf("uatom")).QuoInt64(votePeriodsPerWindow).TruncateInt(),
+		outstandingRewards.AmountOf("uatom"))
+}
+
+func (s *IntegrationTestSuite) TestGetRewardPools() {
+	givingAmt := sdk.NewCoins(
+		sdk.NewInt64Coin(types.UmeeDenom, 30000000),
+		sdk.NewInt64Coin("uother", 50000000),
+	)
+	err := s.app.BankKeeper.MintCoins(s.ctx, "leverage", givingAmt)
+	s.Require().NoError(err)
+	err = s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, "leverage", "oracle", givingAmt)
+	s.Require().NoError(err)
+
+	distributionRatio := sdk.NewDec((int64)(s.app.OracleKeeper.VotePeriod(s.ctx))).
+		QuoInt64((int64)(s.app.OracleKeeper.RewardDistributionWindow(s.ctx)))
+
+	rewardPools := s.app.OracleKeeper.GetRewardPools(s.ctx)
+	s.Require().Len(rewardPools, 2)
+	s.Require().Equal(sdk.NewInt64Coin("uother", 50000000), rewardPools[0].Balance)
+	s.Require().Empty(rewardPools[0].BallotDenom)
+	s.Require().Equal(sdk.NewInt64Coin(types.UmeeDenom, 30000000), rewardPools[1].Balance)
+	s.Require().Equal("UMEE", rewardPools[1].BallotDenom)
+	s.Require().Equal(sdk.NewDec(30000000).Mul(distributionRatio), rewardPools[1].PeriodReward.Amount)
 }
@@ -1,36 +1,48 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/umee-network/umee/x/oracle/types"
 )
 
 // SlashAndResetMissCounters iterates over all the current missed counters and
 // calculates the "valid vote rate" as:
 // (votePeriodsPerWindow - missCounter)/votePeriodsPerWindow.
 //
-// If the valid vote rate is below the minValidPerWindow, the validator will be
-// slashed and jailed.
+// If the valid vote rate is below the minValidPerWindow, the validator commits
+// an offense and its offense counter is increased. Offenses are penalized
+// gradually: the first one only emits a warning event, while every further one
+// slashes and jails the validator with a slash fraction escalating by
+// SlashEscalationRate, up to MaxSlashFraction. Validators that bonded during
+// the current slash window are exempt, and the offense counter of validators
+// that did not offend in the window is decreased by one.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
 	var (
-		slashWindow          = int64(k.SlashWindow(ctx))
-		votePeriod           = int64(k.VotePeriod(ctx))
+		params               = k.GetParams(ctx)
+		slashWindow          = int64(params.SlashWindow)
+		votePeriod           = int64(params.VotePeriod)
 		votePeriodsPerWindow = sdk.NewDec(slashWindow).QuoInt64(votePeriod).TruncateInt64()
+		windowStartHeight    = height - slashWindow + 1
 	)
 
 	var (
-		minValidPerWindow = k.MinValidPerWindow(ctx)
-		slashFraction     = k.SlashFraction(ctx)
+		minValidPerWindow = params.MinValidPerWindow
 		powerReduction    = k.StakingKeeper.PowerReduction(ctx)
+		offenders         = make(map[string]bool)
 	)
 
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
 		diff := sdk.NewInt(votePeriodsPerWindow - int64(missCounter))
 		validVoteRate := sdk.NewDecFromInt(diff).QuoInt64(votePeriodsPerWindow)
+		outOfBandCounter := k.GetOutOfBandCounter(ctx, operator)
 
-		// Slash and jail the validator if their valid vote rate is smaller than the
+		// Penalize the validator if their valid vote rate is smaller than the
 		// minimum threshold.
 		if validVoteRate.LT(minValidPerWindow) {
 			validator := k.StakingKeeper.Validator(ctx, operator)
@@ -40,18 +52,64 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 					panic(err)
 				}
 
-				k.StakingKeeper.Slash(
-					ctx,
-					consAddr,
-					distributionHeight,
-					validator.GetConsensusPower(powerReduction), slashFraction,
-				)
+				// validators get a grace period for their first slash window after
+				// bonding
+				signingInfo, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+				if found && signingInfo.StartHeight >= windowStartHeight {
+					k.DeleteMissCounter(ctx, operator)
+					k.DeleteOutOfBandCounter(ctx, operator)
+					return false
+				}
+
+				offenders[operator.String()] = true
+				offenseCounter := k.GetOffenseCounter(ctx, operator) + 1
+				k.SetOffenseCounter(ctx, operator, offenseCounter)
+
+				attributes := []sdk.Attribute{
+					sdk.NewAttribute(types.EventAttrKeyOperator, operator.String()),
+					sdk.NewAttribute(types.EventAttrKeyMissCounter, strconv.FormatUint(missCounter, 10)),
+					sdk.NewAttribute(types.EventAttrKeyOutOfBand, strconv.FormatUint(outOfBandCounter, 10)),
+					sdk.NewAttribute(types.EventAttrKeyOffense, strconv.FormatUint(offenseCounter, 10)),
+				}
+
+				slashFraction := params.SlashFractionForOffense(offenseCounter)
+				if slashFraction.IsZero() {
+					ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSlashWarning, attributes...))
+				} else {
+					k.StakingKeeper.Slash(
+						ctx,
+						consAddr,
+						distributionHeight,
+						validator.GetConsensusPower(powerReduction), slashFraction,
+					)
+
+					k.StakingKeeper.Jail(ctx, consAddr)
 
-				k.StakingKeeper.Jail(ctx, consAddr)
+					ctx.EventManager().EmitEvent(sdk.NewEvent(
+						types.EventTypeSlash,
+						append(attributes, sdk.NewAttribute(types.EventAttrKeySlashFraction, slashFraction.String()))...,
+					))
+				}
 			}
 		}
 
 		k.DeleteMissCounter(ctx, operator)
+		k.DeleteOutOfBandCounter(ctx, operator)
+		return false
+	})
+
+	// validators that did not offend during the window work off one offense
+	k.IterateOffenseCounters(ctx, func(operator sdk.ValAddress, offenseCounter uint64) bool {
+		if offenders[operator.String()] {
+			return false
+		}
+
+		if offenseCounter <= 1 {
+			k.DeleteOffenseCounter(ctx, operator)
+		} else {
+			k.SetOffenseCounter(ctx, operator, offenseCounter-1)
+		}
+
 		return false
 	})
 }
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/umee-network/umee/x/oracle/types"
 )
 
 func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
@@ -14,7 +16,12 @@ func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
 	s.Require().Equal(amt, s.app.StakingKeeper.Validator(s.ctx, addr).GetBondedTokens())
 	s.Require().Equal(amt, s.app.StakingKeeper.Validator(s.ctx, addr2).GetBondedTokens())
 
-	votePeriodsPerWindow := sdk.NewDec(int64(s.app.OracleKeeper.SlashWindow(s.ctx))).QuoInt64(int64(s.app.OracleKeeper.VotePeriod(s.ctx))).TruncateInt64()
+	// move past the first slash window so that validators are not in their
+	// grace period anymore
+	slashWindow := int64(s.app.OracleKeeper.SlashWindow(s.ctx))
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + slashWindow)
+
+	votePeriodsPerWindow := sdk.NewDec(slashWindow).QuoInt64(int64(s.app.OracleKeeper.VotePeriod(s.ctx))).TruncateInt64()
 	slashFraction := s.app.OracleKeeper.SlashFraction(s.ctx)
 	minValidVotes := s.app.OracleKeeper.MinValidPerWindow(s.ctx).MulInt64(votePeriodsPerWindow).TruncateInt64()
 	// Case 1, no slash
@@ -24,15 +31,27 @@ func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
 
 	validator, _ := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 	s.Require().Equal(amt, validator.GetBondedTokens())
+	s.Require().Equal(uint64(0), s.app.OracleKeeper.GetOffenseCounter(s.ctx, valAddr))
+
+	// Case 2, first offense only warns
+	s.app.OracleKeeper.SetMissCounter(s.ctx, valAddr, uint64(votePeriodsPerWindow-minValidVotes+1))
+	s.app.OracleKeeper.SetOutOfBandCounter(s.ctx, valAddr, 1)
+	s.app.OracleKeeper.SlashAndResetMissCounters(s.ctx)
+	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().Equal(amt, validator.GetBondedTokens())
+	s.Require().False(validator.Jailed)
+	s.Require().Equal(uint64(1), s.app.OracleKeeper.GetOffenseCounter(s.ctx, valAddr))
+	s.Require().Equal(uint64(0), s.app.OracleKeeper.GetOutOfBandCounter(s.ctx, valAddr))
 
-	// Case 2, slash
+	// Case 3, repeated offense slashes and jails
 	s.app.OracleKeeper.SetMissCounter(s.ctx, valAddr, uint64(votePeriodsPerWindow-minValidVotes+1))
 	s.app.OracleKeeper.SlashAndResetMissCounters(s.ctx)
 	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 	s.Require().Equal(amt.Sub(slashFraction.MulInt(amt).TruncateInt()), validator.GetBondedTokens())
 	s.Require().True(validator.Jailed)
+	s.Require().Equal(uint64(2), s.app.OracleKeeper.GetOffenseCounter(s.ctx, valAddr))
 
-	// Case 3, slash unbonded validator
+	// Case 4, slash unbonded validator
 	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 	validator.Status = stakingtypes.Unbonded
 	validator.Jailed = false
@@ -45,7 +64,10 @@ func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
 	s.Require().Equal(amt, validator.Tokens)
 	s.Require().False(validator.Jailed)
 
-	// Case 4, slash jailed validator
+	// a window without offense works off one offense
+	s.Require().Equal(uint64(1), s.app.OracleKeeper.GetOffenseCounter(s.ctx, valAddr))
+
+	// Case 5, slash jailed validator
 	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 	validator.Status = stakingtypes.Bonded
 	validator.Jailed = true
@@ -56,4 +78,35 @@ func (s *IntegrationTestSuite) TestSlashAndResetMissCounters() {
 	s.app.OracleKeeper.SlashAndResetMissCounters(s.ctx)
 	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 	s.Require().Equal(amt, validator.Tokens)
+	s.Require().Equal(uint64(0), s.app.OracleKeeper.GetOffenseCounter(s.ctx, valAddr))
+}
+
+func (s *IntegrationTestSuite) TestSlashAndResetMissCounters_GracePeriod() {
+	votePeriodsPerWindow := sdk.NewDec(int64(s.app.OracleKeeper.SlashWindow(s.ctx))).QuoInt64(int64(s.app.OracleKeeper.VotePeriod(s.ctx))).TruncateInt64()
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+	// validators bonded during the current slash window are exempt
+	s.app.OracleKeeper.SetMissCounter(s.ctx, valAddr, uint64(votePeriodsPerWindow))
+	s.app.OracleKeeper.SlashAndResetMissCounters(s.ctx)
+
+	validator, _ := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
+	s.Require().Equal(amt, validator.GetBondedTokens())
+	s.Require().False(validator.Jailed)
+	s.Require().Equal(uint64(0), s.app.OracleKeeper.GetOffenseCounter(s.ctx, valAddr))
+	s.Require().Equal(uint64(0), s.app.OracleKeeper.GetMissCounter(s.ctx, valAddr))
+}
+
+func (s *IntegrationTestSuite) TestSlashFractionForOffense() {
+	params := types.DefaultParams()
+	params.SlashFraction = sdk.NewDecWithPrec(1, 3)
+	params.SlashEscalationRate = sdk.NewDec(3)
+	params.MaxSlashFraction = sdk.NewDecWithPrec(1, 2)
+
+	s.Require().True(params.SlashFractionForOffense(0).IsZero())
+	s.Require().True(params.SlashFractionForOffense(1).IsZero())
+	s.Require().Equal(sdk.NewDecWithPrec(1, 3), params.SlashFractionForOffense(2))
+	s.Require().Equal(sdk.NewDecWithPrec(3, 3), params.SlashFractionForOffense(3))
+	s.Require().Equal(sdk.NewDecWithPrec(9, 3), params.SlashFractionForOffense(4))
+	s.Require().Equal(sdk.NewDecWithPrec(1, 2), params.SlashFractionForOffense(5))
+	s.Require().Equal(sdk.NewDecWithPrec(1, 2), params.SlashFractionForOffense(1000))
 }
@@ -27,7 +27,9 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.Equal(kvA.Key[:1], types.KeyPrefixFeederDelegation):
 			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
 
-		case bytes.Equal(kvA.Key[:1], types.KeyPrefixMissCounter):
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixMissCounter),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixOutOfBandCounter),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixOffenseCounter):
 			var counterA, counterB gogotypes.UInt64Value
 			cdc.MustUnmarshal(kvA.Value, &counterA)
 			cdc.MustUnmarshal(kvB.Value, &counterB)
@@ -45,6 +47,18 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixExchangeRateDerivation):
+			var derivationA, derivationB types.ExchangeRateDerivation
+			cdc.MustUnmarshal(kvA.Value, &derivationA)
+			cdc.MustUnmarshal(kvB.Value, &derivationB)
+			return fmt.Sprintf("%v\n%v", derivationA, derivationB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixExchangeRateOverride):
+			var overrideA, overrideB types.ExchangeRateOverride
+			cdc.MustUnmarshal(kvA.Value, &overrideA)
+			cdc.MustUnmarshal(kvB.Value, &overrideB)
+			return fmt.Sprintf("%v\n%v", overrideA, overrideB)
+
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
@@ -19,6 +19,9 @@ const (
 	slashFractionKey            = "slash_fraction"
 	slashWindowKey              = "slash_window"
 	minValidPerWindowKey        = "min_valid_per_window"
+	slashEscalationRateKey      = "slash_escalation_rate"
+	maxSlashFractionKey         = "max_slash_fraction"
+	removeDelistedDenomsKey     = "remove_delisted_denoms"
 )
 
 // GenVotePeriod produces a randomized VotePeriod in the range of [5, 100]
@@ -56,6 +59,21 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenSlashEscalationRate produces a randomized SlashEscalationRate in the range of [1.000, 3.000]
+func GenSlashEscalationRate(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(2000)), 3))
+}
+
+// GenMaxSlashFraction produces a randomized MaxSlashFraction in the range of [0.100, 0.200]
+func GenMaxSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(1, 1).Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
+}
+
+// GenRemoveDelistedDenoms produces a randomized RemoveDelistedDenoms
+func GenRemoveDelistedDenoms(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -100,6 +118,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var slashEscalationRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashEscalationRateKey, &slashEscalationRate, simState.Rand,
+		func(r *rand.Rand) { slashEscalationRate = GenSlashEscalationRate(r) },
+	)
+
+	var maxSlashFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxSlashFractionKey, &maxSlashFraction, simState.Rand,
+		func(r *rand.Rand) { maxSlashFraction = GenMaxSlashFraction(r) },
+	)
+
+	var removeDelistedDenoms bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, removeDelistedDenomsKey, &removeDelistedDenoms, simState.Rand,
+		func(r *rand.Rand) { removeDelistedDenoms = GenRemoveDelistedDenoms(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:               votePeriod,
@@ -109,15 +145,21 @@ func RandomizedGenState(simState *module.SimulationState) {
 			AcceptList: types.DenomList{
 				{SymbolDenom: types.UmeeSymbol, BaseDenom: types.UmeeDenom},
 			},
-			SlashFraction:     slashFraction,
-			SlashWindow:       slashWindow,
-			MinValidPerWindow: minValidPerWindow,
+			SlashFraction:        slashFraction,
+			SlashWindow:          slashWindow,
+			MinValidPerWindow:    minValidPerWindow,
+			CrossPairList:        types.CrossPairList{},
+			SlashEscalationRate:  slashEscalationRate,
+			MaxSlashFraction:     maxSlashFraction,
+			RemoveDelistedDenoms: removeDelistedDenoms,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
 		[]types.MissCounter{},
 		[]types.AggregateExchangeRatePrevote{},
 		[]types.AggregateExchangeRateVote{},
+		[]types.OffenseCounter{},
+		[]types.ExchangeRateOverride{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
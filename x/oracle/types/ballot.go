@@ -148,19 +148,22 @@ func BallotMapToSlice(votes map[string]ExchangeRateBallot) []BallotDenom {
 
 // Claim is an interface that directs its rewards to an attached bank account.
 type Claim struct {
-	Power     int64
-	Weight    int64
-	WinCount  int64
-	Recipient sdk.ValAddress
+	Power        int64
+	Weight       int64
+	WinCount     int64
+	VoteCount    int64
+	DenomWeights map[string]int64
+	Recipient    sdk.ValAddress
 }
 
 // NewClaim generates a Claim instance.
 func NewClaim(power, weight, winCount int64, recipient sdk.ValAddress) Claim {
 	return Claim{
-		Power:     power,
-		Weight:    weight,
-		WinCount:  winCount,
-		Recipient: recipient,
+		Power:        power,
+		Weight:       weight,
+		WinCount:     winCount,
+		DenomWeights: make(map[string]int64),
+		Recipient:    recipient,
 	}
 }
 
@@ -170,10 +173,12 @@ func ClaimMapToSlice(claims map[string]Claim) []Claim {
 	i := 0
 	for _, claim := range claims {
 		c[i] = Claim{
-			Power:     claim.Power,
-			Weight:    claim.Weight,
-			WinCount:  claim.WinCount,
-			Recipient: claim.Recipient,
+			Power:        claim.Power,
+			Weight:       claim.Weight,
+			WinCount:     claim.WinCount,
+			VoteCount:    claim.VoteCount,
+			DenomWeights: claim.DenomWeights,
+			Recipient:    claim.Recipient,
 		}
 		i++
 	}
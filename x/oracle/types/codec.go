@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -32,6 +33,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "umee/oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "umee/oracle/MsgAggregateExchangeRateVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "umee/oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&ExchangeRateOverrideProposal{}, "umee/oracle/ExchangeRateOverrideProposal", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -42,5 +44,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAggregateExchangeRateVote{},
 	)
 
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ExchangeRateOverrideProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CrossPairSeparator separates the base and the quote symbol of a cross pair
+// denom (e.g. STATOM/ATOM).
+const CrossPairSeparator = "/"
+
+// String implements fmt.Stringer interface
+func (cp CrossPair) String() string {
+	out, _ := yaml.Marshal(cp)
+	return string(out)
+}
+
+// Equal implements equal interface
+func (cp CrossPair) Equal(cp1 *CrossPair) bool {
+	return cp.Base == cp1.Base &&
+		cp.Quote == cp1.Quote
+}
+
+// Denom returns the denom validators vote on for the cross pair, formatted as
+// {BASE}/{QUOTE}.
+func (cp CrossPair) Denom() string {
+	return CrossPairDenom(cp.Base, cp.Quote)
+}
+
+// CrossPairDenom returns the vote denom of a pair of symbols, formatted as
+// {BASE}/{QUOTE}.
+func CrossPairDenom(base, quote string) string {
+	return strings.ToUpper(base) + CrossPairSeparator + strings.ToUpper(quote)
+}
+
+// CrossPairList is array of CrossPair
+type CrossPairList []CrossPair
+
+// String implements fmt.Stringer interface
+func (cpl CrossPairList) String() (out string) {
+	for _, cp := range cpl {
+		out += cp.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// Contains checks whether or not a cross pair vote denom (e.g. STATOM/ATOM) is
+// in the CrossPairList.
+func (cpl CrossPairList) Contains(denom string) bool {
+	_, ok := cpl.Get(denom)
+	return ok
+}
+
+// Get returns the cross pair with the given vote denom (e.g. STATOM/ATOM).
+func (cpl CrossPairList) Get(denom string) (CrossPair, bool) {
+	for _, cp := range cpl {
+		if strings.EqualFold(cp.Denom(), denom) {
+			return cp, true
+		}
+	}
+	return CrossPair{}, false
+}
+
+// GetByBase returns the cross pair whose base is the given symbol denom, if
+// any. A symbol denom can be the base of at most one cross pair.
+func (cpl CrossPairList) GetByBase(symbolDenom string) (CrossPair, bool) {
+	for _, cp := range cpl {
+		if strings.EqualFold(cp.Base, symbolDenom) {
+			return cp, true
+		}
+	}
+	return CrossPair{}, false
+}
+
+// Validate performs basic validation on the cross pairs. It ensures every pair
+// has distinct base and quote symbols, that no base is repeated and that
+// chaining the pairs by their quote never leads to a cycle.
+func (cpl CrossPairList) Validate() error {
+	quoteByBase := make(map[string]string, len(cpl))
+	for _, cp := range cpl {
+		base, quote := strings.ToUpper(cp.Base), strings.ToUpper(cp.Quote)
+		if len(base) == 0 || len(quote) == 0 {
+			return fmt.Errorf("cross pair must have both base and quote")
+		}
+		if strings.Contains(base, CrossPairSeparator) || strings.Contains(quote, CrossPairSeparator) {
+			return fmt.Errorf("cross pair symbols must not contain %s: %s", CrossPairSeparator, cp.Denom())
+		}
+		if base == quote {
+			return fmt.Errorf("cross pair base and quote must differ: %s", cp.Denom())
+		}
+		if _, ok := quoteByBase[base]; ok {
+			return fmt.Errorf("duplicated cross pair base %s", base)
+		}
+
+		quoteByBase[base] = quote
+	}
+
+	for base := range quoteByBase {
+		// a path can visit each base at most once before reaching a quote that
+		// is priced directly in USD
+		symbol := base
+		for i := 0; i <= len(quoteByBase); i++ {
+			quote, ok := quoteByBase[symbol]
+			if !ok {
+				break
+			}
+			if i == len(quoteByBase) {
+				return fmt.Errorf("cross pair %s cannot be resolved to USD", CrossPairDenom(base, quoteByBase[base]))
+			}
+
+			symbol = quote
+		}
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (d ExchangeRateDerivation) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// PathString returns the derivation path formatted as a comma separated list
+// of pairs, e.g. STATOM/ATOM,ATOM/USD.
+func (d ExchangeRateDerivation) PathString() string {
+	pairs := make([]string, len(d.Path))
+	for i, hop := range d.Path {
+		pairs[i] = hop.Denom
+	}
+
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrossPairDenom(t *testing.T) {
+	cp := CrossPair{Base: "stAtom", Quote: "atom"}
+	require.Equal(t, "STATOM/ATOM", cp.Denom())
+
+	cpl := CrossPairList{cp}
+	require.True(t, cpl.Contains("statom/atom"))
+	require.False(t, cpl.Contains("ATOM/STATOM"))
+
+	_, ok := cpl.GetByBase("STATOM")
+	require.True(t, ok)
+	_, ok = cpl.GetByBase("ATOM")
+	require.False(t, ok)
+}
+
+func TestCrossPairListValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pairs     CrossPairList
+		expectErr bool
+	}{
+		{"empty", CrossPairList{}, false},
+		{"single pair", CrossPairList{{Base: "STATOM", Quote: "ATOM"}}, false},
+		{"chained pairs", CrossPairList{{Base: "STATOM", Quote: "ATOM"}, {Base: "ATOM", Quote: "OSMO"}}, false},
+		{"empty quote", CrossPairList{{Base: "STATOM"}}, true},
+		{"same base and quote", CrossPairList{{Base: "ATOM", Quote: "atom"}}, true},
+		{"separator in symbol", CrossPairList{{Base: "ST/ATOM", Quote: "ATOM"}}, true},
+		{"duplicated base", CrossPairList{{Base: "STATOM", Quote: "ATOM"}, {Base: "STATOM", Quote: "OSMO"}}, true},
+		{
+			"cycle",
+			CrossPairList{{Base: "STATOM", Quote: "ATOM"}, {Base: "ATOM", Quote: "OSMO"}, {Base: "OSMO", Quote: "STATOM"}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pairs.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsVoteTargets(t *testing.T) {
+	p := DefaultParams()
+	p.AcceptList = append(p.AcceptList,
+		Denom{BaseDenom: "uatom", SymbolDenom: "ATOM", Exponent: 6},
+		Denom{BaseDenom: "ustatom", SymbolDenom: "STATOM", Exponent: 6},
+	)
+	p.CrossPairList = CrossPairList{{Base: "STATOM", Quote: "ATOM"}}
+	require.NoError(t, p.Validate())
+	require.Equal(t, []string{UmeeSymbol, "ATOM", "STATOM/ATOM"}, p.VoteTargets())
+
+	// cross pairs must only reference accepted denoms
+	p.CrossPairList = CrossPairList{{Base: "STATOM", Quote: "OSMO"}}
+	require.Error(t, p.Validate())
+}
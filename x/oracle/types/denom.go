@@ -16,7 +16,8 @@ func (d Denom) String() string {
 func (d Denom) Equal(d1 *Denom) bool {
 	return d.BaseDenom == d1.BaseDenom &&
 		d.SymbolDenom == d1.SymbolDenom &&
-		d.Exponent == d1.Exponent
+		d.Exponent == d1.Exponent &&
+		d.Optional == d1.Optional
 }
 
 // DenomList is array of Denom
@@ -40,3 +41,14 @@ func (dl DenomList) Contains(symbolDenom string) bool {
 	}
 	return false
 }
+
+// GetByBase returns the Denom with the given BaseDenom (e.g. uumee), along
+// with its index in the DenomList.
+func (dl DenomList) GetByBase(baseDenom string) (Denom, int, bool) {
+	for i, d := range dl {
+		if d.BaseDenom == baseDenom {
+			return d, i, true
+		}
+	}
+	return Denom{}, -1, false
+}
@@ -2,12 +2,17 @@ package types
 
 // Oracle module event types
 const (
-	EventTypeExchangeRateUpdate = "exchange_rate_update"
-	EventTypePrevote            = "prevote"
-	EventTypeVote               = "vote"
-	EventTypeFeedDelegate       = "feed_delegate"
-	EventTypeAggregatePrevote   = "aggregate_prevote"
-	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeExchangeRateUpdate     = "exchange_rate_update"
+	EventTypePrevote                = "prevote"
+	EventTypeVote                   = "vote"
+	EventTypeFeedDelegate           = "feed_delegate"
+	EventTypeAggregatePrevote       = "aggregate_prevote"
+	EventTypeAggregateVote          = "aggregate_vote"
+	EventTypeExchangeRateDerivation = "exchange_rate_derivation"
+	EventTypeSlashWarning           = "slash_warning"
+	EventTypeSlash                  = "slash"
+	EventTypeExchangeRateOverride   = "exchange_rate_override"
+	EventTypeOverrideExpired        = "exchange_rate_override_expired"
 
 	EventAttrKeyDenom         = "denom"
 	EventAttrKeyVoter         = "voter"
@@ -15,5 +20,11 @@ const (
 	EventAttrKeyExchangeRates = "exchange_rates"
 	EventAttrKeyOperator      = "operator"
 	EventAttrKeyFeeder        = "feeder"
+	EventAttrKeyDerivation    = "derivation_path"
+	EventAttrKeyMissCounter   = "miss_counter"
+	EventAttrKeyOutOfBand     = "out_of_band_counter"
+	EventAttrKeyOffense       = "offense_counter"
+	EventAttrKeySlashFraction = "slash_fraction"
+	EventAttrKeyExpiryHeight  = "expiry_height"
 	EventAttrValueCategory    = ModuleName
 )
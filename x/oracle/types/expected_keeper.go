@@ -4,7 +4,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	leveragetypes "github.com/umee-network/umee/x/leverage/types"
 )
 
 // StakingKeeper defines the expected interface contract defined by the x/staking
@@ -19,6 +22,12 @@ type StakingKeeper interface {
 	PowerReduction(ctx sdk.Context) (res sdk.Int)
 }
 
+// SlashingKeeper defines the expected interface contract defined by the
+// x/slashing module.
+type SlashingKeeper interface {
+	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
+}
+
 // DistributionKeeper defines the expected interface contract defined by the
 // x/distribution module.
 type DistributionKeeper interface {
@@ -48,3 +57,9 @@ type BankKeeper interface {
 	// only used for simulation
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// LeverageKeeper defines the expected interface contract defined by the
+// x/leverage module.
+type LeverageKeeper interface {
+	GetAllRegisteredTokens(ctx sdk.Context) []leveragetypes.Token
+}
@@ -14,6 +14,8 @@ func NewGenesisState(
 	missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	offenseCounters []OffenseCounter,
+	exchangeRateOverrides []ExchangeRateOverride,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -23,6 +25,8 @@ func NewGenesisState(
 		MissCounters:                  missCounters,
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		OffenseCounters:               offenseCounters,
+		ExchangeRateOverrides:         exchangeRateOverrides,
 	}
 }
 
@@ -36,11 +40,19 @@ func DefaultGenesisState() *GenesisState {
 		MissCounters:                  []MissCounter{},
 		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
 		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
+		OffenseCounters:               []OffenseCounter{},
+		ExchangeRateOverrides:         []ExchangeRateOverride{},
 	}
 }
 
 // ValidateGenesis validates the oracle genesis state.
 func ValidateGenesis(data *GenesisState) error {
+	for _, override := range data.ExchangeRateOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -32,6 +32,8 @@ type GenesisState struct {
 	MissCounters                  []MissCounter                  `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	OffenseCounters               []OffenseCounter               `protobuf:"bytes,7,rep,name=offense_counters,json=offenseCounters,proto3" json:"offense_counters"`
+	ExchangeRateOverrides         []ExchangeRateOverride         `protobuf:"bytes,8,rep,name=exchange_rate_overrides,json=exchangeRateOverrides,proto3" json:"exchange_rate_overrides"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +111,20 @@ func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVo
 	return nil
 }
 
+func (m *GenesisState) GetOffenseCounters() []OffenseCounter {
+	if m != nil {
+		return m.OffenseCounters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateOverrides() []ExchangeRateOverride {
+	if m != nil {
+		return m.ExchangeRateOverrides
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -165,10 +181,12 @@ func (m *FeederDelegation) GetValidatorAddress() string {
 }
 
 // MissCounter defines an miss counter and validator address pair used in
-// oracle module's genesis state
+// oracle module's genesis state. The out of band counter holds the subset of
+// misses where the validator voted outside of the reward band.
 type MissCounter struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	MissCounter      uint64 `protobuf:"varint,2,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+	OutOfBandCounter uint64 `protobuf:"varint,3,opt,name=out_of_band_counter,json=outOfBandCounter,proto3" json:"out_of_band_counter,omitempty"`
 }
 
 func (m *MissCounter) Reset()         { *m = MissCounter{} }
@@ -218,48 +236,115 @@ func (m *MissCounter) GetMissCounter() uint64 {
 	return 0
 }
 
+func (m *MissCounter) GetOutOfBandCounter() uint64 {
+	if m != nil {
+		return m.OutOfBandCounter
+	}
+	return 0
+}
+
+// OffenseCounter defines an offense counter and validator address pair used
+// in oracle module's genesis state
+type OffenseCounter struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OffenseCounter   uint64 `protobuf:"varint,2,opt,name=offense_counter,json=offenseCounter,proto3" json:"offense_counter,omitempty"`
+}
+
+func (m *OffenseCounter) Reset()         { *m = OffenseCounter{} }
+func (m *OffenseCounter) String() string { return proto.CompactTextString(m) }
+func (*OffenseCounter) ProtoMessage()    {}
+func (*OffenseCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d68cf98f19c3dd5, []int{3}
+}
+func (m *OffenseCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffenseCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffenseCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OffenseCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffenseCounter.Merge(m, src)
+}
+func (m *OffenseCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffenseCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffenseCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OffenseCounter proto.InternalMessageInfo
+
+func (m *OffenseCounter) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *OffenseCounter) GetOffenseCounter() uint64 {
+	if m != nil {
+		return m.OffenseCounter
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "umeenetwork.umee.oracle.v1beta1.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "umeenetwork.umee.oracle.v1beta1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "umeenetwork.umee.oracle.v1beta1.MissCounter")
+	proto.RegisterType((*OffenseCounter)(nil), "umeenetwork.umee.oracle.v1beta1.OffenseCounter")
 }
 
 func init() { proto.RegisterFile("umee/oracle/v1beta1/genesis.proto", fileDescriptor_2d68cf98f19c3dd5) }
 
 var fileDescriptor_2d68cf98f19c3dd5 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc6, 0xe3, 0x36, 0x8d, 0xf4, 0xdf, 0x24, 0x55, 0xbb, 0xfa, 0x1f, 0xa2, 0x48, 0x75, 0xd2,
-	0x48, 0x88, 0x4a, 0x05, 0x5b, 0x09, 0x37, 0x24, 0x0e, 0x0d, 0x04, 0x4e, 0x48, 0x95, 0x41, 0x20,
-	0x21, 0x21, 0x6b, 0x63, 0x4f, 0x5c, 0x8b, 0xd8, 0x6b, 0xed, 0xac, 0x43, 0x39, 0x73, 0xe5, 0xc0,
-	0x33, 0x70, 0xe4, 0x49, 0x7a, 0xec, 0x91, 0x13, 0xa0, 0xe4, 0x45, 0x90, 0x77, 0xb7, 0x8d, 0x29,
-	0x29, 0x96, 0xb8, 0xd9, 0xb3, 0xbf, 0x6f, 0xbe, 0x6f, 0xec, 0x59, 0x72, 0x98, 0x27, 0x00, 0x2e,
-	0x17, 0x2c, 0x98, 0x83, 0xbb, 0x18, 0x4e, 0x41, 0xb2, 0xa1, 0x1b, 0x41, 0x0a, 0x18, 0xa3, 0x93,
-	0x09, 0x2e, 0x39, 0xed, 0x15, 0x48, 0x0a, 0xf2, 0x3d, 0x17, 0xef, 0x9c, 0xe2, 0xd9, 0xd1, 0xb8,
-	0x63, 0xf0, 0xee, 0xff, 0x11, 0x8f, 0xb8, 0x62, 0xdd, 0xe2, 0x49, 0xcb, 0xba, 0xfd, 0x4d, 0x9d,
-	0x8d, 0x52, 0x13, 0x76, 0xc0, 0x31, 0xe1, 0xe8, 0x4e, 0x19, 0xae, 0x89, 0x80, 0xc7, 0xa9, 0x3e,
-	0x1f, 0x7c, 0xd9, 0x21, 0xad, 0x67, 0x3a, 0xca, 0x0b, 0xc9, 0x24, 0xd0, 0x09, 0x69, 0x64, 0x4c,
-	0xb0, 0x04, 0x3b, 0x56, 0xdf, 0x3a, 0x6a, 0x8e, 0xee, 0x3a, 0x15, 0xd1, 0x9c, 0x53, 0x85, 0x8f,
-	0xeb, 0x17, 0xdf, 0x7b, 0x35, 0xcf, 0x88, 0xe9, 0x8c, 0xd0, 0x19, 0x40, 0x08, 0xc2, 0x0f, 0x61,
-	0x0e, 0x11, 0x93, 0x31, 0x4f, 0xb1, 0xb3, 0xd5, 0xdf, 0x3e, 0x6a, 0x8e, 0x86, 0x95, 0x2d, 0x9f,
-	0x2a, 0xe9, 0x93, 0x6b, 0xa5, 0x69, 0xbe, 0x3f, 0xbb, 0x51, 0x47, 0x9a, 0x93, 0x5d, 0x38, 0x0f,
-	0xce, 0x58, 0x1a, 0x81, 0x2f, 0x98, 0x04, 0xec, 0x6c, 0x2b, 0x8f, 0x51, 0xa5, 0xc7, 0xc4, 0xc8,
-	0x3c, 0x26, 0xe1, 0x65, 0x9e, 0xcd, 0x61, 0xdc, 0x2d, 0x4c, 0xbe, 0xfe, 0xe8, 0xd1, 0x3f, 0x8e,
-	0xd0, 0x6b, 0x43, 0xa9, 0x86, 0xf4, 0x35, 0x69, 0x27, 0x31, 0xa2, 0x1f, 0xf0, 0x3c, 0x95, 0x20,
-	0xb0, 0x53, 0x57, 0xae, 0xf7, 0x2a, 0x5d, 0x9f, 0xc7, 0x88, 0x8f, 0xb5, 0xc8, 0x0c, 0xd5, 0x4a,
-	0xd6, 0x25, 0xa4, 0x9f, 0x2c, 0xd2, 0x67, 0x51, 0x24, 0x8a, 0x01, 0xc1, 0xff, 0x6d, 0x34, 0x3f,
-	0x13, 0xb0, 0xe0, 0xc5, 0x88, 0x3b, 0xca, 0xec, 0x51, 0xa5, 0xd9, 0xc9, 0x55, 0xa3, 0xf2, 0x40,
-	0xa7, 0xba, 0x8b, 0x71, 0x3f, 0x60, 0x7f, 0x61, 0x90, 0x7e, 0xb4, 0xc8, 0xc1, 0x6d, 0x71, 0x74,
-	0x96, 0x86, 0xca, 0xf2, 0xf0, 0xdf, 0xb2, 0xbc, 0x5a, 0x07, 0xe9, 0xb2, 0xdb, 0x00, 0x1c, 0xcc,
-	0xc8, 0xde, 0xcd, 0x8d, 0xa0, 0x77, 0xc8, 0xae, 0x59, 0x30, 0x16, 0x86, 0x02, 0x50, 0xef, 0xeb,
-	0x7f, 0x5e, 0x5b, 0x57, 0x4f, 0x74, 0x91, 0x1e, 0x93, 0xfd, 0x05, 0x9b, 0xc7, 0x21, 0x93, 0x7c,
-	0x4d, 0x6e, 0x29, 0x72, 0xef, 0xfa, 0xc0, 0xc0, 0x83, 0xb7, 0xa4, 0x59, 0xfa, 0x3f, 0x9b, 0xb5,
-	0xd6, 0x66, 0x2d, 0x3d, 0x24, 0xad, 0xf2, 0x46, 0x28, 0x8f, 0xba, 0xd7, 0x2c, 0xfd, 0xdc, 0xf1,
-	0xe4, 0x62, 0x69, 0x5b, 0x97, 0x4b, 0xdb, 0xfa, 0xb9, 0xb4, 0xad, 0xcf, 0x2b, 0xbb, 0x76, 0xb9,
-	0xb2, 0x6b, 0xdf, 0x56, 0x76, 0xed, 0xcd, 0x71, 0x14, 0xcb, 0xb3, 0x7c, 0xea, 0x04, 0x3c, 0x71,
-	0x8b, 0x8f, 0x77, 0xdf, 0x7c, 0x49, 0xf5, 0xe2, 0x9e, 0x5f, 0xdd, 0x70, 0xf9, 0x21, 0x03, 0x9c,
-	0x36, 0xd4, 0xcd, 0x7d, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x7e, 0xc2, 0x2f, 0x57, 0x04,
-	0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xdb, 0x12, 0x60, 0xd3, 0xa6, 0xe9, 0x02, 0x22, 0x8a, 0x54, 0x37, 0x8d, 0x84, 0x5a,
+	0xa9, 0xd4, 0x56, 0x8b, 0xb8, 0x20, 0x71, 0x68, 0xa0, 0x70, 0x42, 0xad, 0x02, 0x02, 0x89, 0x8b,
+	0xd9, 0xd8, 0x63, 0xd7, 0x22, 0xf6, 0x46, 0x3b, 0xeb, 0x50, 0xce, 0x5c, 0x7b, 0xe0, 0x3b, 0xf8,
+	0x92, 0x1e, 0x7b, 0xe4, 0x04, 0xa8, 0xfd, 0x0a, 0x6e, 0xc8, 0xbb, 0xdb, 0x26, 0x2e, 0x29, 0x56,
+	0xb9, 0xd9, 0x33, 0xef, 0xcd, 0x7b, 0xb3, 0x33, 0xbb, 0x64, 0x35, 0x4b, 0x00, 0x5c, 0x2e, 0x98,
+	0x3f, 0x00, 0x77, 0xb4, 0xd5, 0x07, 0xc9, 0xb6, 0xdc, 0x08, 0x52, 0xc0, 0x18, 0x9d, 0xa1, 0xe0,
+	0x92, 0xd3, 0x95, 0x1c, 0x92, 0x82, 0xfc, 0xc4, 0xc5, 0x47, 0x27, 0xff, 0x76, 0x34, 0xdc, 0x31,
+	0xf0, 0xd6, 0xdd, 0x88, 0x47, 0x5c, 0x61, 0xdd, 0xfc, 0x4b, 0xd3, 0x5a, 0xed, 0x69, 0x95, 0x0d,
+	0x53, 0x23, 0x6c, 0x9f, 0x63, 0xc2, 0xd1, 0xed, 0x33, 0x1c, 0x23, 0x7c, 0x1e, 0xa7, 0x3a, 0xdf,
+	0xf9, 0x5d, 0x25, 0xf3, 0x2f, 0xb5, 0x95, 0xd7, 0x92, 0x49, 0xa0, 0xbb, 0xa4, 0x3a, 0x64, 0x82,
+	0x25, 0xd8, 0xb4, 0xda, 0xd6, 0x7a, 0x6d, 0x7b, 0xcd, 0x29, 0xb1, 0xe6, 0xec, 0x2b, 0x78, 0x77,
+	0xee, 0xf8, 0xc7, 0x4a, 0xa5, 0x67, 0xc8, 0x34, 0x24, 0x34, 0x04, 0x08, 0x40, 0x78, 0x01, 0x0c,
+	0x20, 0x62, 0x32, 0xe6, 0x29, 0x36, 0x67, 0xda, 0xb3, 0xeb, 0xb5, 0xed, 0xad, 0xd2, 0x92, 0x2f,
+	0x14, 0xf5, 0xf9, 0x05, 0xd3, 0x14, 0x5f, 0x0a, 0x2f, 0xc5, 0x91, 0x66, 0xa4, 0x0e, 0x87, 0xfe,
+	0x01, 0x4b, 0x23, 0xf0, 0x04, 0x93, 0x80, 0xcd, 0x59, 0xa5, 0xb1, 0x5d, 0xaa, 0xb1, 0x6b, 0x68,
+	0x3d, 0x26, 0xe1, 0x4d, 0x36, 0x1c, 0x40, 0xb7, 0x95, 0x8b, 0x7c, 0xfb, 0xb9, 0x42, 0xff, 0x4a,
+	0x61, 0x6f, 0x01, 0x26, 0x62, 0x48, 0xdf, 0x91, 0x85, 0x24, 0x46, 0xf4, 0x7c, 0x9e, 0xa5, 0x12,
+	0x04, 0x36, 0xe7, 0x94, 0xea, 0xc3, 0x52, 0xd5, 0x57, 0x31, 0xe2, 0x33, 0x4d, 0x32, 0x4d, 0xcd,
+	0x27, 0xe3, 0x10, 0xd2, 0x23, 0x8b, 0xb4, 0x59, 0x14, 0x89, 0xbc, 0x41, 0xf0, 0x0a, 0xad, 0x79,
+	0x43, 0x01, 0x23, 0x9e, 0xb7, 0x78, 0x43, 0x89, 0x3d, 0x2d, 0x15, 0xdb, 0x39, 0x2f, 0x34, 0xd9,
+	0xd0, 0xbe, 0xae, 0x62, 0xd4, 0x97, 0xd9, 0x3f, 0x30, 0x48, 0xbf, 0x58, 0x64, 0xf9, 0x2a, 0x3b,
+	0xda, 0x4b, 0x55, 0x79, 0x79, 0xf2, 0x7f, 0x5e, 0xde, 0x8e, 0x8d, 0xb4, 0xd8, 0x55, 0x00, 0xa4,
+	0x1f, 0x48, 0x83, 0x87, 0x21, 0xa4, 0x08, 0xe3, 0x03, 0xbf, 0xa9, 0x74, 0xdd, 0x52, 0xdd, 0x3d,
+	0x4d, 0x2c, 0x9e, 0xf9, 0x22, 0x2f, 0x44, 0x91, 0x22, 0xb9, 0x5f, 0x6c, 0x8e, 0x8f, 0x40, 0x88,
+	0x38, 0x00, 0x6c, 0xde, 0x52, 0x42, 0x8f, 0xaf, 0xb5, 0x4f, 0x7b, 0x86, 0x6d, 0xe4, 0xee, 0xc1,
+	0x94, 0x1c, 0x76, 0x42, 0xd2, 0xb8, 0xbc, 0xe8, 0xf4, 0x01, 0xa9, 0x9b, 0x7b, 0xc3, 0x82, 0x40,
+	0x00, 0xea, 0x6b, 0x78, 0xbb, 0xb7, 0xa0, 0xa3, 0x3b, 0x3a, 0x48, 0x37, 0xc8, 0xd2, 0x88, 0x0d,
+	0xe2, 0x80, 0x49, 0x3e, 0x46, 0xce, 0x28, 0x64, 0xe3, 0x22, 0x61, 0xc0, 0x9d, 0x23, 0x8b, 0xd4,
+	0x26, 0xf6, 0x6e, 0x3a, 0xd9, 0x9a, 0x4e, 0xa6, 0xab, 0x64, 0x7e, 0x72, 0xd3, 0x95, 0xc8, 0x5c,
+	0xaf, 0x36, 0xb1, 0xb4, 0x74, 0x93, 0xdc, 0xe1, 0x99, 0xf4, 0x78, 0xe8, 0xf5, 0x59, 0x1a, 0x5c,
+	0x20, 0x67, 0x15, 0xb2, 0xc1, 0x33, 0xb9, 0x17, 0x76, 0x59, 0x1a, 0x18, 0x78, 0x27, 0x24, 0xf5,
+	0xe2, 0x50, 0xae, 0x67, 0x68, 0x8d, 0x2c, 0x5e, 0x5a, 0x06, 0xe3, 0xa9, 0x5e, 0x1c, 0x6a, 0x77,
+	0xf7, 0xf8, 0xd4, 0xb6, 0x4e, 0x4e, 0x6d, 0xeb, 0xd7, 0xa9, 0x6d, 0x7d, 0x3d, 0xb3, 0x2b, 0x27,
+	0x67, 0x76, 0xe5, 0xfb, 0x99, 0x5d, 0x79, 0xbf, 0x11, 0xc5, 0xf2, 0x20, 0xeb, 0x3b, 0x3e, 0x4f,
+	0xdc, 0x7c, 0x94, 0x9b, 0x66, 0xae, 0xea, 0xc7, 0x3d, 0x3c, 0x7f, 0x50, 0xe5, 0xe7, 0x21, 0x60,
+	0xbf, 0xaa, 0x1e, 0xca, 0x47, 0x7f, 0x06, 0x00, 0x18, 0xfb, 0xc4, 0x2a, 0xc6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -282,6 +367,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExchangeRateOverrides) > 0 {
+		for iNdEx := len(m.ExchangeRateOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OffenseCounters) > 0 {
+		for iNdEx := len(m.OffenseCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OffenseCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.AggregateExchangeRateVotes) > 0 {
 		for iNdEx := len(m.AggregateExchangeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -422,6 +535,11 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OutOfBandCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutOfBandCounter))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MissCounter != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.MissCounter))
 		i--
@@ -437,6 +555,41 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OffenseCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffenseCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffenseCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OffenseCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OffenseCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -486,6 +639,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OffenseCounters) > 0 {
+		for _, e := range m.OffenseCounters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateOverrides) > 0 {
+		for _, e := range m.ExchangeRateOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -519,6 +684,25 @@ func (m *MissCounter) Size() (n int) {
 	if m.MissCounter != 0 {
 		n += 1 + sovGenesis(uint64(m.MissCounter))
 	}
+	if m.OutOfBandCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.OutOfBandCounter))
+	}
+	return n
+}
+
+func (m *OffenseCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.OffenseCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.OffenseCounter))
+	}
 	return n
 }
 
@@ -760,6 +944,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenseCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffenseCounters = append(m.OffenseCounters, OffenseCounter{})
+			if err := m.OffenseCounters[len(m.OffenseCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateOverrides = append(m.ExchangeRateOverrides, ExchangeRateOverride{})
+			if err := m.ExchangeRateOverrides[len(m.ExchangeRateOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -975,6 +1227,126 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfBandCounter", wireType)
+			}
+			m.OutOfBandCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutOfBandCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OffenseCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffenseCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffenseCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenseCounter", wireType)
+			}
+			m.OffenseCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenseCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -10,6 +11,15 @@ func TestGenesisValidation(t *testing.T) {
 	genState := DefaultGenesisState()
 	require.NoError(t, ValidateGenesis(genState))
 
+	genState.ExchangeRateOverrides = []ExchangeRateOverride{
+		{Denom: "ATOM", ExchangeRate: sdk.OneDec(), ExpiryHeight: 100},
+	}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.ExchangeRateOverrides[0].ExchangeRate = sdk.ZeroDec()
+	require.Error(t, ValidateGenesis(genState))
+
+	genState = DefaultGenesisState()
 	genState.Params.VotePeriod = 0
 	require.Error(t, ValidateGenesis(genState))
 }
@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: umee/oracle/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExchangeRateOverrideProposal defines a governance proposal type where the
+// exchange rate of a denom is pinned for a bounded number of blocks, e.g. when
+// validators cannot price it. The pinned rate is only used while the denom has
+// no valid tally, and the override expires automatically.
+type ExchangeRateOverrideProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// denom defines the symbol denom (e.g. ATOM) to pin the exchange rate of.
+	Denom        string                                 `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// duration defines the number of blocks after which the override expires.
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *ExchangeRateOverrideProposal) Reset()      { *m = ExchangeRateOverrideProposal{} }
+func (*ExchangeRateOverrideProposal) ProtoMessage() {}
+func (*ExchangeRateOverrideProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0cb2db15c38583f, []int{0}
+}
+func (m *ExchangeRateOverrideProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateOverrideProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateOverrideProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateOverrideProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateOverrideProposal.Merge(m, src)
+}
+func (m *ExchangeRateOverrideProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateOverrideProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateOverrideProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateOverrideProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExchangeRateOverrideProposal)(nil), "umeenetwork.umee.oracle.v1beta1.ExchangeRateOverrideProposal")
+}
+
+func init() { proto.RegisterFile("umee/oracle/v1beta1/gov.proto", fileDescriptor_d0cb2db15c38583f) }
+
+var fileDescriptor_d0cb2db15c38583f = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xb1, 0x4f, 0xfa, 0x40,
+	0x18, 0xed, 0xf1, 0x83, 0x5f, 0xb4, 0xe2, 0xd2, 0x30, 0x34, 0x44, 0x7b, 0x84, 0xc1, 0x90, 0x18,
+	0x7a, 0x21, 0x6e, 0x8c, 0x44, 0x5c, 0x35, 0x1d, 0x5d, 0xcc, 0xd1, 0x7e, 0x29, 0x0d, 0x6d, 0xbf,
+	0xe6, 0x7a, 0x20, 0xcc, 0x2e, 0x8e, 0x8e, 0x8e, 0xfc, 0x39, 0x8c, 0x8c, 0xc6, 0x81, 0x18, 0x58,
+	0x9c, 0xfd, 0x0b, 0xcc, 0x5d, 0xab, 0xa9, 0xd3, 0x7d, 0xef, 0xde, 0x7b, 0x77, 0xef, 0xde, 0x99,
+	0xe7, 0xf3, 0x04, 0x80, 0xa1, 0xe0, 0x7e, 0x0c, 0x6c, 0x31, 0x98, 0x80, 0xe4, 0x03, 0x16, 0xe2,
+	0xc2, 0xcd, 0x04, 0x4a, 0xb4, 0xa8, 0xa2, 0x53, 0x90, 0x8f, 0x28, 0x66, 0xae, 0x9a, 0xdd, 0x42,
+	0xea, 0x96, 0xd2, 0x76, 0x2b, 0xc4, 0x10, 0xb5, 0x96, 0xa9, 0xa9, 0xb0, 0x75, 0x9f, 0x6a, 0xe6,
+	0xd9, 0x78, 0xe9, 0x4f, 0x79, 0x1a, 0x82, 0xc7, 0x25, 0xdc, 0x2e, 0x40, 0x88, 0x28, 0x80, 0x3b,
+	0x81, 0x19, 0xe6, 0x3c, 0xb6, 0x5a, 0x66, 0x43, 0x46, 0x32, 0x06, 0x9b, 0x74, 0x48, 0xef, 0xd8,
+	0x2b, 0x80, 0xd5, 0x31, 0x4f, 0x02, 0xc8, 0x7d, 0x11, 0x65, 0x32, 0xc2, 0xd4, 0xae, 0x69, 0xae,
+	0xba, 0xa5, 0x7c, 0x01, 0xa4, 0x98, 0xd8, 0xff, 0x0a, 0x9f, 0x06, 0xd6, 0xcc, 0x3c, 0x85, 0xf2,
+	0xb6, 0x07, 0xc1, 0x25, 0xd8, 0x75, 0xc5, 0x8e, 0x6e, 0x36, 0x3b, 0x6a, 0xbc, 0xef, 0xe8, 0x45,
+	0x18, 0xc9, 0xe9, 0x7c, 0xe2, 0xfa, 0x98, 0x30, 0x1f, 0xf3, 0x04, 0xf3, 0x72, 0xe9, 0xe7, 0xc1,
+	0x8c, 0xc9, 0x55, 0x06, 0xb9, 0x7b, 0x0d, 0xfe, 0xd7, 0x8e, 0xb6, 0x56, 0x3c, 0x89, 0x87, 0xdd,
+	0x3f, 0x87, 0x75, 0xbd, 0x26, 0x54, 0x9e, 0x62, 0xb5, 0xcd, 0xa3, 0x60, 0x2e, 0xb8, 0x4e, 0xd8,
+	0xe8, 0x90, 0x5e, 0xdd, 0xfb, 0xc5, 0xc3, 0xe6, 0xf3, 0x9a, 0x1a, 0xaf, 0x6b, 0x6a, 0x7c, 0xae,
+	0x29, 0x19, 0x8d, 0x37, 0x7b, 0x87, 0x6c, 0xf7, 0x0e, 0xf9, 0xd8, 0x3b, 0xe4, 0xe5, 0xe0, 0x18,
+	0xdb, 0x83, 0x63, 0xbc, 0x1d, 0x1c, 0xe3, 0xfe, 0xb2, 0x92, 0x48, 0xb5, 0xda, 0x2f, 0x2b, 0xd6,
+	0x80, 0x2d, 0x7f, 0xfe, 0x43, 0x47, 0x9b, 0xfc, 0xd7, 0x9d, 0x5e, 0x7d, 0x0f, 0x00, 0xb9, 0x3e,
+	0xb4, 0xcf, 0xab, 0x01, 0x00, 0x00,
+}
+
+func (this *ExchangeRateOverrideProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExchangeRateOverrideProposal)
+	if !ok {
+		that2, ok := that.(ExchangeRateOverrideProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.ExchangeRate.Equal(that1.ExchangeRate) {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
+func (m *ExchangeRateOverrideProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateOverrideProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateOverrideProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExchangeRateOverrideProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.Duration != 0 {
+		n += 1 + sovGov(uint64(m.Duration))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExchangeRateOverrideProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateOverrideProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateOverrideProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
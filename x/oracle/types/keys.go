@@ -26,6 +26,10 @@ var (
 	KeyPrefixMissCounter                  = []byte{0x03} // prefix for each key to a miss counter
 	KeyPrefixAggregateExchangeRatePrevote = []byte{0x04} // prefix for each key to a aggregate prevote
 	KeyPrefixAggregateExchangeRateVote    = []byte{0x05} // prefix for each key to a aggregate vote
+	KeyPrefixExchangeRateDerivation       = []byte{0x06} // prefix for each key to a rate derivation
+	KeyPrefixOutOfBandCounter             = []byte{0x07} // prefix for each key to an out of band counter
+	KeyPrefixOffenseCounter               = []byte{0x08} // prefix for each key to an offense counter
+	KeyPrefixExchangeRateOverride         = []byte{0x09} // prefix for each key to a rate override
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -35,6 +39,20 @@ func GetExchangeRateKey(denom string) (key []byte) {
 	return append(key, 0) // append 0 for null-termination
 }
 
+// GetExchangeRateDerivationKey - stored by *denom*
+func GetExchangeRateDerivationKey(denom string) (key []byte) {
+	key = append(key, KeyPrefixExchangeRateDerivation...)
+	key = append(key, []byte(denom)...)
+	return append(key, 0) // append 0 for null-termination
+}
+
+// GetExchangeRateOverrideKey - stored by *denom*
+func GetExchangeRateOverrideKey(denom string) (key []byte) {
+	key = append(key, KeyPrefixExchangeRateOverride...)
+	key = append(key, []byte(denom)...)
+	return append(key, 0) // append 0 for null-termination
+}
+
 // GetFeederDelegationKey - stored by *Validator* address
 func GetFeederDelegationKey(v sdk.ValAddress) (key []byte) {
 	key = append(key, KeyPrefixFeederDelegation...)
@@ -47,6 +65,18 @@ func GetMissCounterKey(v sdk.ValAddress) (key []byte) {
 	return append(key, address.MustLengthPrefix(v)...)
 }
 
+// GetOutOfBandCounterKey - stored by *Validator* address
+func GetOutOfBandCounterKey(v sdk.ValAddress) (key []byte) {
+	key = append(key, KeyPrefixOutOfBandCounter...)
+	return append(key, address.MustLengthPrefix(v)...)
+}
+
+// GetOffenseCounterKey - stored by *Validator* address
+func GetOffenseCounterKey(v sdk.ValAddress) (key []byte) {
+	key = append(key, KeyPrefixOffenseCounter...)
+	return append(key, address.MustLengthPrefix(v)...)
+}
+
 // GetAggregateExchangeRatePrevoteKey - stored by *Validator* address
 func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) (key []byte) {
 	key = append(key, KeyPrefixAggregateExchangeRatePrevote...)
@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -35,6 +35,13 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	CrossPairList            CrossPairList                          `protobuf:"bytes,9,rep,name=cross_pair_list,json=crossPairList,proto3,castrepeated=CrossPairList" json:"cross_pair_list" yaml:"cross_pair_list"`
+	SlashEscalationRate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=slash_escalation_rate,json=slashEscalationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_escalation_rate" yaml:"slash_escalation_rate"`
+	MaxSlashFraction         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_slash_fraction,json=maxSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slash_fraction" yaml:"max_slash_fraction"`
+	// remove_delisted_denoms defines whether denoms delisted from the x/leverage
+	// Token registry are removed from the accept list. Otherwise, they are kept
+	// and marked as optional.
+	RemoveDelistedDenoms bool `protobuf:"varint,12,opt,name=remove_delisted_denoms,json=removeDelistedDenoms,proto3" json:"remove_delisted_denoms,omitempty" yaml:"remove_delisted_denoms"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -97,11 +104,28 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetCrossPairList() CrossPairList {
+	if m != nil {
+		return m.CrossPairList
+	}
+	return nil
+}
+
+func (m *Params) GetRemoveDelistedDenoms() bool {
+	if m != nil {
+		return m.RemoveDelistedDenoms
+	}
+	return false
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	BaseDenom   string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
 	SymbolDenom string `protobuf:"bytes,2,opt,name=symbol_denom,json=symbolDenom,proto3" json:"symbol_denom,omitempty" yaml:"symbol_denom"`
 	Exponent    uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty" yaml:"exponent"`
+	// optional defines whether validators may skip votes on the denom without
+	// being counted as missing.
+	Optional bool `protobuf:"varint,4,opt,name=optional,proto3" json:"optional,omitempty" yaml:"optional"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -136,6 +160,46 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+// CrossPair - a pair that validators vote on against a non-USD quote asset.
+// The USD exchange rate of the base asset is derived from the tallied cross
+// rate and the USD exchange rate of the quote asset.
+type CrossPair struct {
+	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty" yaml:"base"`
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty" yaml:"quote"`
+}
+
+func (m *CrossPair) Reset()      { *m = CrossPair{} }
+func (*CrossPair) ProtoMessage() {}
+func (*CrossPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_217d01b4a642f644, []int{2}
+}
+func (m *CrossPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossPair.Merge(m, src)
+}
+func (m *CrossPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossPair proto.InternalMessageInfo
+
 // AggregateExchangeRatePrevote -
 // struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
@@ -150,7 +214,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_217d01b4a642f644, []int{2}
+	return fileDescriptor_217d01b4a642f644, []int{3}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +253,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_217d01b4a642f644, []int{3}
+	return fileDescriptor_217d01b4a642f644, []int{4}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +291,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_217d01b4a642f644, []int{4}
+	return fileDescriptor_217d01b4a642f644, []int{5}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,68 +320,227 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
+// ExchangeRateDerivation - struct to store the path through which the USD
+// exchange rate of a denom voted against a non-USD quote was derived. Each
+// hop holds the pair (e.g. STATOM/ATOM or ATOM/USD) and its rate.
+type ExchangeRateDerivation struct {
+	Denom string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Path  ExchangeRateTuples `protobuf:"bytes,2,rep,name=path,proto3,castrepeated=ExchangeRateTuples" json:"path" yaml:"path"`
+}
+
+func (m *ExchangeRateDerivation) Reset()      { *m = ExchangeRateDerivation{} }
+func (*ExchangeRateDerivation) ProtoMessage() {}
+func (*ExchangeRateDerivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_217d01b4a642f644, []int{6}
+}
+func (m *ExchangeRateDerivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateDerivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateDerivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateDerivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateDerivation.Merge(m, src)
+}
+func (m *ExchangeRateDerivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateDerivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateDerivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateDerivation proto.InternalMessageInfo
+
+// RewardPool - struct to hold the oracle reward balance of a denom, which is
+// shared at the end of every vote period among the winners of the ballot the
+// denom is attributed to.
+type RewardPool struct {
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance" yaml:"balance"`
+	// ballot_denom is the ballot (e.g. ATOM or STATOM/ATOM) whose winners share
+	// the pool. Pools that are not attributed to a ballot are shared among the
+	// winners of all ballots.
+	BallotDenom string `protobuf:"bytes,2,opt,name=ballot_denom,json=ballotDenom,proto3" json:"ballot_denom,omitempty" yaml:"ballot_denom"`
+	// period_reward is the projected reward paid out at the end of the vote
+	// period.
+	PeriodReward types.DecCoin `protobuf:"bytes,3,opt,name=period_reward,json=periodReward,proto3" json:"period_reward" yaml:"period_reward"`
+}
+
+func (m *RewardPool) Reset()      { *m = RewardPool{} }
+func (*RewardPool) ProtoMessage() {}
+func (*RewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_217d01b4a642f644, []int{7}
+}
+func (m *RewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPool.Merge(m, src)
+}
+func (m *RewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPool proto.InternalMessageInfo
+
+// ExchangeRateOverride - struct to store an exchange rate pinned by governance
+// until the expiry height. The exchange rate is only applied while the denom
+// has no valid tally.
+type ExchangeRateOverride struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	ExpiryHeight int64                                  `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// applied defines whether the current exchange rate of the denom is the
+	// pinned one.
+	Applied bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty" yaml:"applied"`
+}
+
+func (m *ExchangeRateOverride) Reset()      { *m = ExchangeRateOverride{} }
+func (*ExchangeRateOverride) ProtoMessage() {}
+func (*ExchangeRateOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_217d01b4a642f644, []int{8}
+}
+func (m *ExchangeRateOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateOverride.Merge(m, src)
+}
+func (m *ExchangeRateOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateOverride proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "umeenetwork.umee.oracle.v1beta1.Params")
 	proto.RegisterType((*Denom)(nil), "umeenetwork.umee.oracle.v1beta1.Denom")
+	proto.RegisterType((*CrossPair)(nil), "umeenetwork.umee.oracle.v1beta1.CrossPair")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "umeenetwork.umee.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "umeenetwork.umee.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "umeenetwork.umee.oracle.v1beta1.ExchangeRateTuple")
+	proto.RegisterType((*ExchangeRateDerivation)(nil), "umeenetwork.umee.oracle.v1beta1.ExchangeRateDerivation")
+	proto.RegisterType((*RewardPool)(nil), "umeenetwork.umee.oracle.v1beta1.RewardPool")
+	proto.RegisterType((*ExchangeRateOverride)(nil), "umeenetwork.umee.oracle.v1beta1.ExchangeRateOverride")
 }
 
 func init() { proto.RegisterFile("umee/oracle/v1beta1/oracle.proto", fileDescriptor_217d01b4a642f644) }
 
 var fileDescriptor_217d01b4a642f644 = []byte{
-	// 795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x69, 0x1b, 0x9a, 0x49, 0x42, 0xa9, 0x9b, 0x82, 0x69, 0x51, 0x1c, 0x06, 0x51, 0x2a,
-	0xa1, 0xc6, 0x6a, 0x41, 0x42, 0xe4, 0x86, 0x95, 0x96, 0x0b, 0x48, 0x91, 0x55, 0x15, 0x89, 0x8b,
-	0x35, 0xb6, 0x87, 0xc4, 0x8a, 0xed, 0x89, 0xc6, 0x93, 0xa6, 0xbd, 0x70, 0xe6, 0xc8, 0x91, 0x1b,
-	0xbd, 0x70, 0xe1, 0x0e, 0xe2, 0x4f, 0xe8, 0xb1, 0xc7, 0xd5, 0x1e, 0xbc, 0xab, 0xf6, 0xb2, 0x67,
-	0xef, 0x3f, 0xb0, 0x9a, 0x1f, 0x69, 0x9c, 0x26, 0x52, 0xb7, 0xda, 0x53, 0xe6, 0x9b, 0xef, 0xbd,
-	0xef, 0xfd, 0x9a, 0x17, 0x83, 0xd6, 0x38, 0xc6, 0xd8, 0x22, 0x14, 0xf9, 0x11, 0xb6, 0xce, 0x0f,
-	0x3d, 0xcc, 0xd0, 0xa1, 0x82, 0xed, 0x11, 0x25, 0x8c, 0xe8, 0x26, 0xb7, 0x48, 0x30, 0x9b, 0x10,
-	0x3a, 0x6c, 0xf3, 0x73, 0x5b, 0xd1, 0xca, 0x7a, 0xa7, 0xd1, 0x27, 0x7d, 0x22, 0x6c, 0x2d, 0x7e,
-	0x92, 0x6e, 0x3b, 0x4d, 0x9f, 0xa4, 0x31, 0x49, 0x2d, 0x0f, 0xa5, 0x33, 0x61, 0x9f, 0x84, 0x89,
-	0xe4, 0xe1, 0xdf, 0x65, 0x50, 0xee, 0x21, 0x8a, 0xe2, 0x54, 0xff, 0x16, 0x54, 0xcf, 0x09, 0xc3,
-	0xee, 0x08, 0xd3, 0x90, 0x04, 0x86, 0xd6, 0xd2, 0xf6, 0x57, 0xed, 0x8f, 0xf2, 0xcc, 0xd4, 0x2f,
-	0x51, 0x1c, 0x75, 0x60, 0x81, 0x84, 0x0e, 0xe0, 0xa8, 0x27, 0x80, 0x9e, 0x80, 0x0f, 0x04, 0xc7,
-	0x06, 0x14, 0xa7, 0x03, 0x12, 0x05, 0xc6, 0x7b, 0x2d, 0x6d, 0xbf, 0x62, 0xff, 0x70, 0x9d, 0x99,
-	0xa5, 0xe7, 0x99, 0xb9, 0xd7, 0x0f, 0xd9, 0x60, 0xec, 0xb5, 0x7d, 0x12, 0x5b, 0x2a, 0x1d, 0xf9,
-	0x73, 0x90, 0x06, 0x43, 0x8b, 0x5d, 0x8e, 0x70, 0xda, 0xee, 0x62, 0x3f, 0xcf, 0xcc, 0xed, 0x42,
-	0xa4, 0x7b, 0x35, 0xe8, 0xd4, 0xf9, 0xc5, 0xe9, 0x14, 0xeb, 0x18, 0x54, 0x29, 0x9e, 0x20, 0x1a,
-	0xb8, 0x1e, 0x4a, 0x02, 0x63, 0x45, 0x04, 0xeb, 0x3e, 0x39, 0x98, 0x2a, 0xab, 0x20, 0x05, 0x1d,
-	0x20, 0x91, 0x8d, 0x92, 0x40, 0xf7, 0xc1, 0x8e, 0xe2, 0x82, 0x30, 0x65, 0x34, 0xf4, 0xc6, 0x2c,
-	0x24, 0x89, 0x3b, 0x09, 0x93, 0x80, 0x4c, 0x8c, 0x55, 0xd1, 0x9e, 0x2f, 0xf2, 0xcc, 0xfc, 0x6c,
-	0x4e, 0x67, 0x89, 0x2d, 0x74, 0x0c, 0x49, 0x76, 0x0b, 0xdc, 0xcf, 0x82, 0xd2, 0x47, 0xa0, 0x8a,
-	0x7c, 0x1f, 0x8f, 0x98, 0x1b, 0x85, 0x29, 0x33, 0xd6, 0x5a, 0x2b, 0xfb, 0xd5, 0xa3, 0xbd, 0xf6,
-	0x23, 0xc3, 0x6e, 0x77, 0x71, 0x42, 0x62, 0xfb, 0x4b, 0x5e, 0xf3, 0xac, 0x92, 0x82, 0x10, 0xfc,
-	0xe7, 0x85, 0x59, 0x11, 0x46, 0x3f, 0x86, 0x29, 0x73, 0x80, 0xa4, 0xf8, 0x99, 0x4f, 0x2b, 0x8d,
-	0x50, 0x3a, 0x70, 0x7f, 0xa5, 0xc8, 0xe7, 0x99, 0x18, 0xe5, 0x77, 0x9b, 0xd6, 0xbc, 0x1a, 0x74,
-	0xea, 0xe2, 0xe2, 0x44, 0x61, 0xbd, 0x03, 0x6a, 0xd2, 0x42, 0x35, 0xee, 0x7d, 0xd1, 0xb8, 0x8f,
-	0xf3, 0xcc, 0xdc, 0x2a, 0xfa, 0x4f, 0x5b, 0x55, 0x15, 0x50, 0x75, 0xe7, 0x37, 0xd0, 0x88, 0xc3,
-	0xc4, 0x3d, 0x47, 0x51, 0x18, 0xf0, 0xa7, 0x37, 0xd5, 0x58, 0x17, 0x19, 0xff, 0xf4, 0xe4, 0x8c,
-	0x77, 0x65, 0xc4, 0x65, 0x9a, 0xd0, 0xd9, 0x8c, 0xc3, 0xe4, 0x8c, 0xdf, 0xf6, 0x30, 0x95, 0xf1,
-	0x3b, 0xeb, 0x7f, 0x5e, 0x99, 0xa5, 0x57, 0x57, 0xa6, 0x06, 0xff, 0xd7, 0xc0, 0x9a, 0xe8, 0xa7,
-	0xfe, 0x0d, 0x00, 0x7c, 0x99, 0xdc, 0x80, 0x23, 0xb1, 0x25, 0x15, 0x7b, 0x3b, 0xcf, 0xcc, 0x4d,
-	0xa9, 0x3d, 0xe3, 0xa0, 0x53, 0xe1, 0x40, 0x7a, 0xf1, 0x2e, 0x5c, 0xc6, 0x1e, 0x89, 0x94, 0x9f,
-	0xdc, 0x90, 0x62, 0x17, 0x0a, 0x2c, 0xef, 0x82, 0x80, 0xd2, 0xd7, 0x02, 0xeb, 0xf8, 0x62, 0x44,
-	0x12, 0x9c, 0x30, 0xf1, 0xd8, 0xeb, 0xf6, 0x56, 0x9e, 0x99, 0x1b, 0xd2, 0x6f, 0xca, 0x40, 0xe7,
-	0xde, 0xa8, 0x53, 0xfb, 0xfd, 0xca, 0x2c, 0xa9, 0xd4, 0x4b, 0xf0, 0x5f, 0x0d, 0x7c, 0xfa, 0x7d,
-	0xbf, 0x4f, 0x71, 0x1f, 0x31, 0x7c, 0x7c, 0xe1, 0x0f, 0x50, 0xd2, 0xc7, 0x0e, 0x62, 0xb8, 0x47,
-	0x31, 0x5f, 0x2c, 0xfd, 0x73, 0xb0, 0x3a, 0x40, 0xe9, 0x40, 0xd5, 0xb2, 0x91, 0x67, 0x66, 0x55,
-	0x6a, 0xf3, 0x5b, 0xe8, 0x08, 0x52, 0xdf, 0x03, 0x6b, 0xdc, 0x98, 0xaa, 0xcc, 0x3f, 0xcc, 0x33,
-	0xb3, 0x36, 0xdb, 0x56, 0x0a, 0x1d, 0x49, 0x8b, 0x42, 0xc7, 0x5e, 0x1c, 0x32, 0xd7, 0x8b, 0x88,
-	0x3f, 0x14, 0x09, 0xcf, 0x8f, 0xbb, 0xc0, 0xf2, 0x42, 0x05, 0xb4, 0x39, 0x7a, 0x90, 0xf7, 0x6b,
-	0x0d, 0x7c, 0xb2, 0x34, 0xef, 0x33, 0x9e, 0xf4, 0x5f, 0x1a, 0x68, 0x60, 0x75, 0xe9, 0x52, 0xc4,
-	0xff, 0x30, 0xc6, 0xa3, 0x08, 0xa7, 0x86, 0x26, 0x56, 0xe8, 0xe8, 0xd1, 0x15, 0x2a, 0x2a, 0x9e,
-	0x72, 0x57, 0xfb, 0x3b, 0xb5, 0x4e, 0xbb, 0xd3, 0xce, 0x2e, 0xaa, 0xf3, 0xbd, 0xd2, 0x17, 0x3c,
-	0x53, 0x47, 0xc7, 0x0b, 0x77, 0x6f, 0xdb, 0xb1, 0x07, 0x55, 0xff, 0xa7, 0x81, 0xcd, 0x85, 0x00,
-	0x5c, 0xab, 0xf8, 0xde, 0x0a, 0x5a, 0xea, 0xc1, 0x48, 0x5a, 0x1f, 0x82, 0xfa, 0x5c, 0xda, 0x2a,
-	0xf6, 0xc9, 0x93, 0x37, 0xa5, 0xb1, 0xa4, 0x07, 0xd0, 0xa9, 0x15, 0xcb, 0x9c, 0x4f, 0xdc, 0x3e,
-	0xbe, 0xbe, 0x6d, 0x6a, 0x37, 0xb7, 0x4d, 0xed, 0xe5, 0x6d, 0x53, 0xfb, 0xe3, 0xae, 0x59, 0xba,
-	0xb9, 0x6b, 0x96, 0x9e, 0xdd, 0x35, 0x4b, 0xbf, 0x7c, 0x55, 0x88, 0xca, 0x27, 0x71, 0xa0, 0xc6,
-	0x22, 0x80, 0x75, 0x31, 0xfd, 0xec, 0x89, 0xf0, 0x5e, 0x59, 0x7c, 0x97, 0xbe, 0x7e, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x51, 0x3d, 0xd7, 0x99, 0x12, 0x07, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xbb, 0x73, 0x1b, 0xc5,
+	0x1f, 0xd7, 0xd9, 0x8e, 0x6d, 0xad, 0xa4, 0x38, 0x3e, 0xcb, 0xfe, 0x9d, 0x1d, 0xff, 0x74, 0xce,
+	0x32, 0x18, 0xf3, 0x88, 0x34, 0x31, 0xcc, 0x30, 0x78, 0x86, 0x82, 0x43, 0x0e, 0xcc, 0x84, 0x87,
+	0x66, 0xc9, 0x24, 0x33, 0x34, 0x37, 0xab, 0xbb, 0x8d, 0x74, 0xe3, 0xbb, 0xdb, 0xcb, 0xde, 0x49,
+	0xb6, 0x1b, 0x0a, 0x48, 0x41, 0x49, 0x49, 0x87, 0x2b, 0x0a, 0x7a, 0xe8, 0xe9, 0x52, 0xa6, 0x64,
+	0x28, 0x04, 0x63, 0x37, 0xd0, 0x8a, 0x7f, 0x80, 0xd9, 0x87, 0xa4, 0xd5, 0x23, 0xe3, 0x18, 0x1a,
+	0x2a, 0xdd, 0xf7, 0xfb, 0xf9, 0xbe, 0x5f, 0xba, 0x03, 0x3b, 0x9d, 0x88, 0x90, 0x1a, 0x65, 0xd8,
+	0x0b, 0x49, 0xad, 0x7b, 0xa7, 0x49, 0x32, 0x7c, 0x47, 0x91, 0xd5, 0x84, 0xd1, 0x8c, 0x9a, 0x36,
+	0x97, 0x88, 0x49, 0x76, 0x4c, 0xd9, 0x51, 0x95, 0x3f, 0x57, 0x15, 0xac, 0xa4, 0xb7, 0xca, 0x2d,
+	0xda, 0xa2, 0x42, 0xb6, 0xc6, 0x9f, 0xa4, 0xda, 0x56, 0xc5, 0xa3, 0x69, 0x44, 0xd3, 0x5a, 0x13,
+	0xa7, 0x23, 0xc3, 0x1e, 0x0d, 0x62, 0x89, 0xc3, 0x27, 0x00, 0x2c, 0x36, 0x30, 0xc3, 0x51, 0x6a,
+	0xbe, 0x0d, 0x0a, 0x5d, 0x9a, 0x11, 0x37, 0x21, 0x2c, 0xa0, 0xbe, 0x65, 0xec, 0x18, 0x7b, 0x0b,
+	0xce, 0x46, 0xbf, 0x67, 0x9b, 0xa7, 0x38, 0x0a, 0x0f, 0xa0, 0x06, 0x42, 0x04, 0x38, 0xd5, 0x10,
+	0x84, 0x19, 0x83, 0xeb, 0x02, 0xcb, 0xda, 0x8c, 0xa4, 0x6d, 0x1a, 0xfa, 0xd6, 0xdc, 0x8e, 0xb1,
+	0x97, 0x77, 0x3e, 0x78, 0xda, 0xb3, 0x73, 0xbf, 0xf6, 0xec, 0xdd, 0x56, 0x90, 0xb5, 0x3b, 0xcd,
+	0xaa, 0x47, 0xa3, 0x9a, 0x0a, 0x47, 0xfe, 0xdc, 0x4e, 0xfd, 0xa3, 0x5a, 0x76, 0x9a, 0x90, 0xb4,
+	0x5a, 0x27, 0x5e, 0xbf, 0x67, 0xaf, 0x6b, 0x9e, 0x86, 0xd6, 0x20, 0x2a, 0x71, 0xc6, 0xfd, 0x01,
+	0x6d, 0x12, 0x50, 0x60, 0xe4, 0x18, 0x33, 0xdf, 0x6d, 0xe2, 0xd8, 0xb7, 0xe6, 0x85, 0xb3, 0xfa,
+	0x95, 0x9d, 0xa9, 0xb4, 0x34, 0x53, 0x10, 0x01, 0x49, 0x39, 0x38, 0xf6, 0x4d, 0x0f, 0x6c, 0x29,
+	0xcc, 0x0f, 0xd2, 0x8c, 0x05, 0xcd, 0x4e, 0x16, 0xd0, 0xd8, 0x3d, 0x0e, 0x62, 0x9f, 0x1e, 0x5b,
+	0x0b, 0xa2, 0x3c, 0x2f, 0xf7, 0x7b, 0xf6, 0xad, 0x31, 0x3b, 0x33, 0x64, 0x21, 0xb2, 0x24, 0x58,
+	0xd7, 0xb0, 0x87, 0x02, 0x32, 0x13, 0x50, 0xc0, 0x9e, 0x47, 0x92, 0xcc, 0x0d, 0x83, 0x34, 0xb3,
+	0xae, 0xed, 0xcc, 0xef, 0x15, 0xf6, 0x77, 0xab, 0x97, 0x34, 0xbb, 0x5a, 0x27, 0x31, 0x8d, 0x9c,
+	0x57, 0x78, 0xce, 0xa3, 0x4c, 0x34, 0x43, 0xf0, 0x87, 0xdf, 0xec, 0xbc, 0x10, 0xfa, 0x28, 0x48,
+	0x33, 0x04, 0x24, 0xc4, 0x9f, 0x79, 0xb7, 0xd2, 0x10, 0xa7, 0x6d, 0xf7, 0x11, 0xc3, 0x1e, 0x8f,
+	0xc4, 0x5a, 0xfc, 0x77, 0xdd, 0x1a, 0xb7, 0x06, 0x51, 0x49, 0x30, 0xee, 0x2a, 0xda, 0x3c, 0x00,
+	0x45, 0x29, 0xa1, 0x0a, 0xb7, 0x24, 0x0a, 0xf7, 0xbf, 0x7e, 0xcf, 0x5e, 0xd3, 0xf5, 0x07, 0xa5,
+	0x2a, 0x08, 0x52, 0x55, 0xe7, 0x0b, 0x50, 0x8e, 0x82, 0xd8, 0xed, 0xe2, 0x30, 0xf0, 0xf9, 0xe8,
+	0x0d, 0x6c, 0x2c, 0x8b, 0x88, 0x3f, 0xbe, 0x72, 0xc4, 0x37, 0xa5, 0xc7, 0x59, 0x36, 0x21, 0x5a,
+	0x8d, 0x82, 0xf8, 0x01, 0xe7, 0x36, 0x08, 0x53, 0xfe, 0xbf, 0x32, 0xc0, 0x8a, 0xc7, 0x68, 0x9a,
+	0xba, 0x09, 0x0e, 0x98, 0x6c, 0x51, 0x5e, 0xb4, 0xe8, 0xb5, 0x4b, 0x5b, 0xf4, 0x3e, 0xd7, 0x6b,
+	0xe0, 0x80, 0x39, 0x35, 0xd5, 0xa6, 0x0d, 0xe9, 0x7d, 0xc2, 0x20, 0x6f, 0x55, 0x69, 0x28, 0x2c,
+	0xda, 0x55, 0xf2, 0x74, 0xd2, 0xfc, 0xd2, 0x00, 0xeb, 0xb2, 0x48, 0x24, 0xf5, 0x70, 0x88, 0xc5,
+	0x68, 0x31, 0x9c, 0x11, 0x0b, 0x88, 0x3a, 0x7c, 0x72, 0xe5, 0x3a, 0x6c, 0xeb, 0x95, 0x9f, 0x30,
+	0x0a, 0xd1, 0x9a, 0xe0, 0x1f, 0x0e, 0xd9, 0x08, 0x67, 0xc4, 0x3c, 0x05, 0x66, 0x84, 0x4f, 0xdc,
+	0x89, 0xd1, 0x29, 0x88, 0x00, 0xee, 0x5d, 0x39, 0x80, 0x4d, 0xd5, 0x88, 0x29, 0x8b, 0x10, 0xdd,
+	0x88, 0xf0, 0xc9, 0x67, 0x63, 0x13, 0xf4, 0x10, 0x6c, 0x30, 0x12, 0xd1, 0x2e, 0x71, 0x7d, 0xc2,
+	0x2b, 0x46, 0x7c, 0xd7, 0xe7, 0xa3, 0x9d, 0x5a, 0xc5, 0x1d, 0x63, 0x6f, 0xd9, 0xb9, 0xd5, 0xef,
+	0xd9, 0xff, 0x1f, 0x2c, 0xe1, 0x2c, 0x39, 0x88, 0xca, 0x12, 0xa8, 0x2b, 0xbe, 0xd8, 0x8c, 0xf4,
+	0x60, 0xf9, 0xdb, 0x33, 0x3b, 0xf7, 0xc7, 0x99, 0x6d, 0xc0, 0x3f, 0x0d, 0x70, 0x4d, 0x30, 0xcd,
+	0xb7, 0x00, 0xe0, 0xb7, 0x52, 0x6a, 0x8a, 0x23, 0x98, 0x77, 0xd6, 0xfb, 0x3d, 0x7b, 0x55, 0x3a,
+	0x18, 0x61, 0x10, 0xe5, 0x39, 0x21, 0xb5, 0xf8, 0x90, 0x9f, 0x46, 0x4d, 0x1a, 0x2a, 0x3d, 0x79,
+	0x00, 0xf5, 0x21, 0xd7, 0x50, 0x3e, 0xe4, 0x82, 0x94, 0xba, 0x35, 0xb0, 0x4c, 0x4e, 0x12, 0x1a,
+	0x93, 0x38, 0x13, 0xb7, 0xac, 0xe4, 0xac, 0xf5, 0x7b, 0xf6, 0x8a, 0xd4, 0x1b, 0x20, 0x10, 0x0d,
+	0x85, 0xb8, 0x02, 0x4d, 0x78, 0x65, 0x70, 0x28, 0xce, 0xd0, 0xb2, 0xae, 0x30, 0x40, 0x20, 0x1a,
+	0x0a, 0x1d, 0x14, 0xbf, 0x3e, 0xb3, 0x73, 0x2a, 0xd7, 0x1c, 0x7c, 0x04, 0xf2, 0xc3, 0x71, 0x33,
+	0x5f, 0x02, 0x0b, 0x3c, 0x0b, 0x95, 0xe8, 0x4a, 0xbf, 0x67, 0x17, 0x46, 0x89, 0x42, 0x24, 0x40,
+	0x73, 0x17, 0x5c, 0x7b, 0xdc, 0xa1, 0x19, 0x51, 0x69, 0xdd, 0xe8, 0xf7, 0xec, 0xa2, 0x94, 0x12,
+	0x6c, 0x88, 0x24, 0x3c, 0xe1, 0xe7, 0x47, 0x03, 0x6c, 0xbf, 0xd7, 0x6a, 0x31, 0xd2, 0xc2, 0x19,
+	0x39, 0x3c, 0xf1, 0xda, 0x38, 0x6e, 0x11, 0x3e, 0x4b, 0x0d, 0x46, 0xf8, 0x41, 0xe7, 0xbe, 0xdb,
+	0x38, 0x6d, 0x4f, 0xfb, 0xe6, 0x5c, 0x88, 0x04, 0xc8, 0x7d, 0x73, 0x61, 0x36, 0xed, 0x5b, 0xb0,
+	0x21, 0x92, 0xb0, 0xe8, 0x40, 0xa7, 0x19, 0x05, 0x99, 0xdb, 0x0c, 0xa9, 0x77, 0x64, 0xcd, 0x4f,
+	0x9d, 0x19, 0x0d, 0xe5, 0x1d, 0x10, 0xa4, 0xc3, 0xa9, 0x89, 0xb8, 0xff, 0x32, 0xc0, 0xe6, 0xcc,
+	0xb8, 0x1f, 0xf0, 0xa0, 0xbf, 0x33, 0x40, 0x99, 0x28, 0xa6, 0xd8, 0x17, 0x37, 0xeb, 0x24, 0x21,
+	0x49, 0x2d, 0x43, 0xdc, 0x85, 0xfd, 0x4b, 0xef, 0x82, 0x6e, 0xf1, 0x3e, 0x57, 0x75, 0xde, 0x51,
+	0xf7, 0xe1, 0xe6, 0xa0, 0xe5, 0xd3, 0xd6, 0xf9, 0x91, 0x30, 0xa7, 0x34, 0x53, 0x64, 0x92, 0x29,
+	0xde, 0x8b, 0x56, 0x6c, 0x22, 0xeb, 0x9f, 0x0c, 0xb0, 0x3a, 0xe5, 0x80, 0xdb, 0xd2, 0x17, 0x41,
+	0xb3, 0xa5, 0x26, 0x59, 0xc2, 0xe6, 0x11, 0x28, 0x8d, 0x85, 0xad, 0x7c, 0xdf, 0xbd, 0xf2, 0x61,
+	0x28, 0xcf, 0xa8, 0x01, 0x44, 0x45, 0x3d, 0xcd, 0x89, 0xc0, 0x7f, 0x36, 0xc0, 0x86, 0x1e, 0x78,
+	0x9d, 0xb0, 0xa0, 0x2b, 0xee, 0xd6, 0x0b, 0x47, 0xff, 0x18, 0x2c, 0x24, 0x38, 0x6b, 0x5b, 0x73,
+	0xff, 0xb8, 0x85, 0xaf, 0xaa, 0x16, 0xaa, 0x01, 0xe6, 0xd6, 0x9e, 0xd7, 0x32, 0xe1, 0x6a, 0x22,
+	0x87, 0x27, 0x73, 0x00, 0x20, 0xf1, 0x8a, 0xd0, 0xa0, 0x34, 0x34, 0xef, 0x81, 0xa5, 0x26, 0x0e,
+	0x71, 0xec, 0xc9, 0xbd, 0x2c, 0xec, 0x6f, 0x56, 0x65, 0xb9, 0xaa, 0x7c, 0x1d, 0x47, 0xff, 0x30,
+	0x34, 0x88, 0x9d, 0x0d, 0xe5, 0xf9, 0xfa, 0x60, 0x6d, 0x85, 0x1e, 0x44, 0x03, 0x0b, 0x7c, 0x31,
+	0x9a, 0x38, 0x0c, 0x69, 0xf6, 0xbc, 0xd3, 0xa4, 0xa3, 0x10, 0x15, 0x24, 0x29, 0x4f, 0x93, 0x0b,
+	0x4a, 0xf2, 0x85, 0xcf, 0x95, 0x2f, 0x30, 0x62, 0xab, 0x0a, 0xfb, 0xdb, 0x33, 0xc3, 0xa9, 0x13,
+	0x4f, 0x44, 0xb4, 0xad, 0x22, 0x52, 0xad, 0x1c, 0x33, 0x00, 0x51, 0x51, 0xd2, 0x32, 0xdb, 0x89,
+	0x32, 0x7c, 0x3f, 0x07, 0xca, 0x7a, 0xc5, 0x3e, 0xed, 0x12, 0xc6, 0x02, 0xff, 0xbf, 0x39, 0x86,
+	0xe6, 0xbb, 0xdc, 0x59, 0x12, 0xb0, 0x53, 0xb7, 0x4d, 0x82, 0x56, 0x5b, 0x1e, 0xef, 0x79, 0xc7,
+	0xd2, 0xd5, 0x35, 0x58, 0xa8, 0x73, 0xfa, 0x43, 0x41, 0x9a, 0x6f, 0x80, 0x25, 0x9c, 0x24, 0x61,
+	0x40, 0x7c, 0x75, 0xc4, 0xcd, 0x51, 0x17, 0x15, 0x00, 0xd1, 0x40, 0x64, 0xbc, 0x50, 0xce, 0xe1,
+	0xd3, 0xf3, 0x8a, 0xf1, 0xec, 0xbc, 0x62, 0xfc, 0x7e, 0x5e, 0x31, 0xbe, 0xb9, 0xa8, 0xe4, 0x9e,
+	0x5d, 0x54, 0x72, 0xbf, 0x5c, 0x54, 0x72, 0x9f, 0xbf, 0xae, 0xa5, 0xc8, 0x47, 0xf7, 0xb6, 0x9a,
+	0x63, 0x41, 0xd4, 0x4e, 0x06, 0x9f, 0x18, 0x22, 0xd7, 0xe6, 0xa2, 0xf8, 0x06, 0x78, 0xf3, 0xef,
+	0x01, 0x00, 0x26, 0x6f, 0xd1, 0x49, 0x7e, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -368,6 +591,23 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if len(this.CrossPairList) != len(that1.CrossPairList) {
+		return false
+	}
+	for i := range this.CrossPairList {
+		if !this.CrossPairList[i].Equal(&that1.CrossPairList[i]) {
+			return false
+		}
+	}
+	if !this.SlashEscalationRate.Equal(that1.SlashEscalationRate) {
+		return false
+	}
+	if !this.MaxSlashFraction.Equal(that1.MaxSlashFraction) {
+		return false
+	}
+	if this.RemoveDelistedDenoms != that1.RemoveDelistedDenoms {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -390,6 +630,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RemoveDelistedDenoms {
+		i--
+		if m.RemoveDelistedDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MaxSlashFraction.Size()
+		i -= size
+		if _, err := m.MaxSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.SlashEscalationRate.Size()
+		i -= size
+		if _, err := m.SlashEscalationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.CrossPairList) > 0 {
+		for iNdEx := len(m.CrossPairList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossPairList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -482,6 +766,16 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Optional {
+		i--
+		if m.Optional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Exponent != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.Exponent))
 		i--
@@ -504,6 +798,43 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CrossPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -630,59 +961,221 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExchangeRateDerivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExchangeRateDerivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateDerivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
-	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
-	}
-	if len(m.AcceptList) > 0 {
-		for _, e := range m.AcceptList {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Path[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
+	{
+		size, err := m.PeriodReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BallotDenom) > 0 {
+		i -= len(m.BallotDenom)
+		copy(dAtA[i:], m.BallotDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BallotDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.AcceptList) > 0 {
+		for _, e := range m.AcceptList {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.CrossPairList) > 0 {
+		for _, e := range m.CrossPairList {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashEscalationRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxSlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RemoveDelistedDenoms {
+		n += 2
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	l = len(m.SymbolDenom)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
@@ -690,6 +1183,26 @@ func (m *Denom) Size() (n int) {
 	if m.Exponent != 0 {
 		n += 1 + sovOracle(uint64(m.Exponent))
 	}
+	if m.Optional {
+		n += 2
+	}
+	return n
+}
+
+func (m *CrossPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	return n
 }
 
@@ -747,6 +1260,63 @@ func (m *ExchangeRateTuple) Size() (n int) {
 	return n
 }
 
+func (m *ExchangeRateDerivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Path) > 0 {
+		for _, e := range m.Path {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.BallotDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.PeriodReward.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ExchangeRateOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovOracle(uint64(m.ExpiryHeight))
+	}
+	if m.Applied {
+		n += 2
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -902,31 +1472,621 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptList = append(m.AcceptList, Denom{})
+			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossPairList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossPairList = append(m.CrossPairList, CrossPair{})
+			if err := m.CrossPairList[len(m.CrossPairList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEscalationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashEscalationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDelistedDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveDelistedDenoms = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptList = append(m.AcceptList, Denom{})
-			if err := m.AcceptList[len(m.AcceptList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
 			}
-			var stringLen uint64
+			m.SubmitBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -936,31 +2096,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SubmitBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SlashWindow = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -970,14 +2165,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1005,9 +2215,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1030,7 +2238,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1053,15 +2261,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1089,11 +2297,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1121,27 +2329,10 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
-			}
-			m.Exponent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1163,7 +2354,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateDerivation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1186,15 +2377,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateDerivation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateDerivation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,13 +2413,13 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1238,43 +2429,26 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
-			}
-			m.SubmitBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Path = append(m.Path, ExchangeRateTuple{})
+			if err := m.Path[len(m.Path)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1296,7 +2470,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *RewardPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1319,15 +2493,15 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1354,14 +2528,13 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1389,7 +2562,40 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.BallotDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1412,7 +2618,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1435,10 +2641,10 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1507,6 +2713,45 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
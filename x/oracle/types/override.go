@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// String implements fmt.Stringer interface
+func (o ExchangeRateOverride) String() string {
+	out, _ := yaml.Marshal(o)
+	return string(out)
+}
+
+// IsExpired returns true if the override is no longer in effect at the given
+// block height.
+func (o ExchangeRateOverride) IsExpired(height int64) bool {
+	return height >= o.ExpiryHeight
+}
+
+// Validate performs a basic validation of an exchange rate override.
+func (o ExchangeRateOverride) Validate() error {
+	if len(o.Denom) == 0 {
+		return sdkerrors.Wrap(ErrUnknownDenom, "denom cannot be empty")
+	}
+
+	if o.ExchangeRate.IsNil() || !o.ExchangeRate.IsPositive() {
+		return sdkerrors.Wrap(ErrNegativeOrZeroRate, o.Denom)
+	}
+
+	if o.ExpiryHeight <= 0 {
+		return fmt.Errorf("invalid expiry height of %s override: %d", o.Denom, o.ExpiryHeight)
+	}
+
+	return nil
+}
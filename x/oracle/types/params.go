@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -18,6 +19,10 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyCrossPairList            = []byte("CrossPairList")
+	KeySlashEscalationRate      = []byte("SlashEscalationRate")
+	KeyMaxSlashFraction         = []byte("MaxSlashFraction")
+	KeyRemoveDelistedDenoms     = []byte("RemoveDelistedDenoms")
 )
 
 // Default parameter values
@@ -38,8 +43,12 @@ var (
 			Exponent:    UmeeExponent,
 		},
 	}
-	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4) // 0.01%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultSlashFraction        = sdk.NewDecWithPrec(1, 4) // 0.01%
+	DefaultMinValidPerWindow    = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultCrossPairList        CrossPairList
+	DefaultSlashEscalationRate  = sdk.NewDec(2)            // 2x per repeated offense
+	DefaultMaxSlashFraction     = sdk.NewDecWithPrec(1, 2) // 1%
+	DefaultRemoveDelistedDenoms = false
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -55,6 +64,10 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		CrossPairList:            DefaultCrossPairList,
+		SlashEscalationRate:      DefaultSlashEscalationRate,
+		MaxSlashFraction:         DefaultMaxSlashFraction,
+		RemoveDelistedDenoms:     DefaultRemoveDelistedDenoms,
 	}
 }
 
@@ -107,6 +120,26 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 			&p.MinValidPerWindow,
 			validateMinValidPerWindow,
 		),
+		paramstypes.NewParamSetPair(
+			KeyCrossPairList,
+			&p.CrossPairList,
+			validateCrossPairList,
+		),
+		paramstypes.NewParamSetPair(
+			KeySlashEscalationRate,
+			&p.SlashEscalationRate,
+			validateSlashEscalationRate,
+		),
+		paramstypes.NewParamSetPair(
+			KeyMaxSlashFraction,
+			&p.MaxSlashFraction,
+			validateSlashFraction,
+		),
+		paramstypes.NewParamSetPair(
+			KeyRemoveDelistedDenoms,
+			&p.RemoveDelistedDenoms,
+			validateBool,
+		),
 	}
 }
 
@@ -137,6 +170,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter SlashFraction must be between [0, 1]")
 	}
 
+	if p.SlashEscalationRate.LT(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter SlashEscalationRate must be greater than or equal to 1")
+	}
+
+	if p.MaxSlashFraction.GT(sdk.OneDec()) || p.MaxSlashFraction.LT(p.SlashFraction) {
+		return fmt.Errorf("oracle parameter MaxSlashFraction must be between [SlashFraction, 1]")
+	}
+
 	if p.SlashWindow < p.VotePeriod {
 		return fmt.Errorf("oracle parameter SlashWindow must be greater than or equal with VotePeriod")
 	}
@@ -153,9 +194,75 @@ func (p Params) Validate() error {
 			return fmt.Errorf("oracle parameter AcceptList Denom must have SymbolDenom")
 		}
 	}
+
+	if err := p.CrossPairList.Validate(); err != nil {
+		return fmt.Errorf("oracle parameter CrossPairList is invalid: %w", err)
+	}
+	for _, cp := range p.CrossPairList {
+		if !p.AcceptList.Contains(cp.Base) || !p.AcceptList.Contains(cp.Quote) {
+			return fmt.Errorf("oracle parameter CrossPairList %s must only contain AcceptList denoms", cp.Denom())
+		}
+	}
+
 	return nil
 }
 
+// SlashFractionForOffense returns the fraction to slash a validator by for its
+// nth consecutive offense. The first offense only results in a warning, the
+// second one is slashed by SlashFraction, and every further offense escalates
+// the fraction by SlashEscalationRate up to MaxSlashFraction.
+func (p Params) SlashFractionForOffense(offense uint64) sdk.Dec {
+	if offense < 2 {
+		return sdk.ZeroDec()
+	}
+
+	fraction := p.SlashFraction
+	for i := uint64(2); i < offense && fraction.LT(p.MaxSlashFraction); i++ {
+		fraction = fraction.Mul(p.SlashEscalationRate)
+	}
+
+	return sdk.MinDec(fraction, p.MaxSlashFraction)
+}
+
+// VoteTargets returns the denoms validators are required to vote on, which
+// excludes optional denoms. Denoms that are the base of a cross pair are voted
+// on against the pair's quote (e.g. STATOM/ATOM) instead of USD, although a
+// USD vote on the base is accepted in place of a missing cross pair vote.
+func (p Params) VoteTargets() []string {
+	voteTargets := make([]string, 0, len(p.AcceptList))
+	for _, d := range p.AcceptList {
+		if d.Optional {
+			continue
+		}
+
+		if cp, ok := p.CrossPairList.GetByBase(d.SymbolDenom); ok {
+			voteTargets = append(voteTargets, cp.Denom())
+			continue
+		}
+
+		voteTargets = append(voteTargets, d.SymbolDenom)
+	}
+
+	return voteTargets
+}
+
+// RewardBallotDenom returns the ballot denom whose winners share the oracle
+// rewards of the given base denom (e.g. uatom). That is the symbol denom of the
+// AcceptList entry, or the cross pair denom if the symbol is voted on against
+// a non-USD quote.
+func (p Params) RewardBallotDenom(baseDenom string) (string, bool) {
+	d, _, ok := p.AcceptList.GetByBase(baseDenom)
+	if !ok {
+		return "", false
+	}
+
+	if cp, ok := p.CrossPairList.GetByBase(d.SymbolDenom); ok {
+		return cp.Denom(), true
+	}
+
+	return strings.ToUpper(d.SymbolDenom), true
+}
+
 func validateVotePeriod(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -251,6 +358,19 @@ func validateSlashFraction(i interface{}) error {
 	return nil
 }
 
+func validateSlashEscalationRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("slash escalation rate must be greater than or equal to 1: %s", v)
+	}
+
+	return nil
+}
+
 func validateSlashWindow(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -280,3 +400,20 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateCrossPairList(i interface{}) error {
+	v, ok := i.(CrossPairList)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
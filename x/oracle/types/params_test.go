@@ -72,3 +72,24 @@ func TestParamsEqual(t *testing.T) {
 	require.NotNil(t, p11.ParamSetPairs())
 	require.NotNil(t, p11.String())
 }
+
+func TestParamsRewardBallotDenom(t *testing.T) {
+	p := DefaultParams()
+	p.AcceptList = DenomList{
+		{BaseDenom: "uumee", SymbolDenom: "umee", Exponent: 6},
+		{BaseDenom: "uatom", SymbolDenom: "ATOM", Exponent: 6},
+		{BaseDenom: "ustatom", SymbolDenom: "STATOM", Exponent: 6},
+	}
+	p.CrossPairList = CrossPairList{{Base: "STATOM", Quote: "ATOM"}}
+
+	ballotDenom, ok := p.RewardBallotDenom("uumee")
+	require.True(t, ok)
+	require.Equal(t, "UMEE", ballotDenom)
+
+	ballotDenom, ok = p.RewardBallotDenom("ustatom")
+	require.True(t, ok)
+	require.Equal(t, "STATOM/ATOM", ballotDenom)
+
+	_, ok = p.RewardBallotDenom("uother")
+	require.False(t, ok)
+}
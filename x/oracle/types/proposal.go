@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProposalTypeExchangeRateOverrideProposal defines the type for a
+	// ExchangeRateOverrideProposal proposal type.
+	ProposalTypeExchangeRateOverrideProposal = "ExchangeRateOverrideProposal"
+
+	// MaxExchangeRateOverrideDuration defines the maximum number of blocks an
+	// exchange rate can be pinned for by a single proposal.
+	MaxExchangeRateOverrideDuration = BlocksPerWeek
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeExchangeRateOverrideProposal)
+	govtypes.RegisterProposalTypeCodec(&ExchangeRateOverrideProposal{}, "umee/ExchangeRateOverrideProposal")
+}
+
+// Assert ExchangeRateOverrideProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &ExchangeRateOverrideProposal{}
+
+func NewExchangeRateOverrideProposal(
+	title, description, denom string,
+	exchangeRate sdk.Dec,
+	duration uint64,
+) *ExchangeRateOverrideProposal {
+	return &ExchangeRateOverrideProposal{
+		Title:        title,
+		Description:  description,
+		Denom:        denom,
+		ExchangeRate: exchangeRate,
+		Duration:     duration,
+	}
+}
+
+// String implements the Stringer interface.
+func (p ExchangeRateOverrideProposal) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// GetTitle returns the title of the proposal.
+func (p *ExchangeRateOverrideProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *ExchangeRateOverrideProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the x/gov routing key of the proposal.
+func (p *ExchangeRateOverrideProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the x/gov type of the proposal.
+func (p *ExchangeRateOverrideProposal) ProposalType() string {
+	return ProposalTypeExchangeRateOverrideProposal
+}
+
+// ValidateBasic validates the proposal returning an error if invalid.
+func (p *ExchangeRateOverrideProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.Denom) == 0 {
+		return sdkerrors.Wrap(ErrUnknownDenom, "denom cannot be empty")
+	}
+
+	if p.ExchangeRate.IsNil() || !p.ExchangeRate.IsPositive() {
+		return sdkerrors.Wrap(ErrNegativeOrZeroRate, p.Denom)
+	}
+
+	if p.Duration == 0 || p.Duration > MaxExchangeRateOverrideDuration {
+		return sdkerrors.Wrapf(
+			ErrInvalidOverrideDuration,
+			"duration must be between 1 and %d blocks; got %d", MaxExchangeRateOverrideDuration, p.Duration,
+		)
+	}
+
+	return nil
+}
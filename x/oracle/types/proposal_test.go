@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRateOverrideProposal_ValidateBasic(t *testing.T) {
+	p := NewExchangeRateOverrideProposal("title", "description", "ATOM", sdk.NewDec(25), 100)
+	require.NoError(t, p.ValidateBasic())
+
+	p.Denom = ""
+	require.ErrorIs(t, p.ValidateBasic(), ErrUnknownDenom)
+
+	p.Denom = "ATOM"
+	p.ExchangeRate = sdk.ZeroDec()
+	require.ErrorIs(t, p.ValidateBasic(), ErrNegativeOrZeroRate)
+
+	p.ExchangeRate = sdk.NewDec(25)
+	p.Duration = 0
+	require.ErrorIs(t, p.ValidateBasic(), ErrInvalidOverrideDuration)
+
+	p.Duration = MaxExchangeRateOverrideDuration + 1
+	require.ErrorIs(t, p.ValidateBasic(), ErrInvalidOverrideDuration)
+
+	p.Duration = MaxExchangeRateOverrideDuration
+	p.Title = ""
+	require.Error(t, p.ValidateBasic())
+}
@@ -77,6 +77,9 @@ type QueryExchangeRatesResponse struct {
 	// exchange_rates defines a list of the exchange rate for all whitelisted
 	// denoms.
 	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
+	// overridden_denoms defines the denoms whose exchange rate is currently
+	// pinned by governance rather than tallied.
+	OverriddenDenoms []string `protobuf:"bytes,2,rep,name=overridden_denoms,json=overriddenDenoms,proto3" json:"overridden_denoms,omitempty"`
 }
 
 func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesResponse{} }
@@ -119,6 +122,13 @@ func (m *QueryExchangeRatesResponse) GetExchangeRates() github_com_cosmos_cosmos
 	return nil
 }
 
+func (m *QueryExchangeRatesResponse) GetOverriddenDenoms() []string {
+	if m != nil {
+		return m.OverriddenDenoms
+	}
+	return nil
+}
+
 // QueryActiveExchangeRatesRequest is the request type for the Query/ActiveExchangeRates RPC method.
 type QueryActiveExchangeRatesRequest struct {
 }
@@ -204,6 +214,269 @@ func (m *QueryActiveExchangeRatesResponse) GetActiveRates() []string {
 	return nil
 }
 
+// QueryExchangeRateDerivationsRequest is the request type for the
+// Query/ExchangeRateDerivations RPC method.
+type QueryExchangeRateDerivationsRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryExchangeRateDerivationsRequest) Reset()         { *m = QueryExchangeRateDerivationsRequest{} }
+func (m *QueryExchangeRateDerivationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateDerivationsRequest) ProtoMessage()    {}
+func (*QueryExchangeRateDerivationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ba5acb6994ddef, []int{4}
+}
+func (m *QueryExchangeRateDerivationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateDerivationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateDerivationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateDerivationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateDerivationsRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateDerivationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateDerivationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateDerivationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateDerivationsRequest proto.InternalMessageInfo
+
+// QueryExchangeRateDerivationsResponse is response type for the
+// Query/ExchangeRateDerivations RPC method.
+type QueryExchangeRateDerivationsResponse struct {
+	// derivations defines the derivation paths of exchange rates derived from
+	// cross pairs in the last vote period.
+	Derivations []ExchangeRateDerivation `protobuf:"bytes,1,rep,name=derivations,proto3" json:"derivations"`
+}
+
+func (m *QueryExchangeRateDerivationsResponse) Reset()         { *m = QueryExchangeRateDerivationsResponse{} }
+func (m *QueryExchangeRateDerivationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateDerivationsResponse) ProtoMessage()    {}
+func (*QueryExchangeRateDerivationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ba5acb6994ddef, []int{5}
+}
+func (m *QueryExchangeRateDerivationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateDerivationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateDerivationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateDerivationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateDerivationsResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateDerivationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateDerivationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateDerivationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateDerivationsResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRateDerivationsResponse) GetDerivations() []ExchangeRateDerivation {
+	if m != nil {
+		return m.Derivations
+	}
+	return nil
+}
+
+// QueryExchangeRateOverridesRequest is the request type for the
+// Query/ExchangeRateOverrides RPC method.
+type QueryExchangeRateOverridesRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryExchangeRateOverridesRequest) Reset()         { *m = QueryExchangeRateOverridesRequest{} }
+func (m *QueryExchangeRateOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateOverridesRequest) ProtoMessage()    {}
+func (*QueryExchangeRateOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ba5acb6994ddef, []int{6}
+}
+func (m *QueryExchangeRateOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateOverridesRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateOverridesRequest proto.InternalMessageInfo
+
+// QueryExchangeRateOverridesResponse is response type for the
+// Query/ExchangeRateOverrides RPC method.
+type QueryExchangeRateOverridesResponse struct {
+	// overrides defines the exchange rate overrides that have not expired yet.
+	Overrides []ExchangeRateOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides"`
+}
+
+func (m *QueryExchangeRateOverridesResponse) Reset()         { *m = QueryExchangeRateOverridesResponse{} }
+func (m *QueryExchangeRateOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateOverridesResponse) ProtoMessage()    {}
+func (*QueryExchangeRateOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ba5acb6994ddef, []int{7}
+}
+func (m *QueryExchangeRateOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateOverridesResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRateOverridesResponse) GetOverrides() []ExchangeRateOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+// QueryRewardPoolsRequest is the request type for the Query/RewardPools RPC
+// method.
+type QueryRewardPoolsRequest struct {
+	// denom defines the base denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRewardPoolsRequest) Reset()         { *m = QueryRewardPoolsRequest{} }
+func (m *QueryRewardPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolsRequest) ProtoMessage()    {}
+func (*QueryRewardPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ba5acb6994ddef, []int{8}
+}
+func (m *QueryRewardPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolsRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolsRequest proto.InternalMessageInfo
+
+// QueryRewardPoolsResponse is response type for the Query/RewardPools RPC
+// method.
+type QueryRewardPoolsResponse struct {
+	// reward_pools defines the remaining reward balances of the oracle module
+	// along with their projected payout per vote period.
+	RewardPools []RewardPool `protobuf:"bytes,1,rep,name=reward_pools,json=rewardPools,proto3" json:"reward_pools"`
+}
+
+func (m *QueryRewardPoolsResponse) Reset()         { *m = QueryRewardPoolsResponse{} }
+func (m *QueryRewardPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolsResponse) ProtoMessage()    {}
+func (*QueryRewardPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ba5acb6994ddef, []int{9}
+}
+func (m *QueryRewardPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolsResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolsResponse) GetRewardPools() []RewardPool {
+	if m != nil {
+		return m.RewardPools
+	}
+	return nil
+}
+
 // QueryFeederDelegationRequest is the request type for the
 // Query/FeederDelegation RPC method.
 type QueryFeederDelegationRequest struct {
@@ -215,7 +488,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{4}
+	return fileDescriptor_72ba5acb6994ddef, []int{10}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,7 +528,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{5}
+	return fileDescriptor_72ba5acb6994ddef, []int{11}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +575,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{6}
+	return fileDescriptor_72ba5acb6994ddef, []int{12}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,13 +609,20 @@ var xxx_messageInfo_QueryMissCounterRequest proto.InternalMessageInfo
 type QueryMissCounterResponse struct {
 	// miss_counter defines the oracle miss counter of a validator
 	MissCounter uint64 `protobuf:"varint,1,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+	// out_of_band_counter defines the number of misses in which the validator
+	// voted outside of the reward band
+	OutOfBandCounter uint64 `protobuf:"varint,2,opt,name=out_of_band_counter,json=outOfBandCounter,proto3" json:"out_of_band_counter,omitempty"`
+	// offense_counter defines the number of slash windows in which the
+	// validator fell below the minimum valid vote rate, decreased by one for
+	// every window in which it did not
+	OffenseCounter uint64 `protobuf:"varint,3,opt,name=offense_counter,json=offenseCounter,proto3" json:"offense_counter,omitempty"`
 }
 
 func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterResponse{} }
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{7}
+	return fileDescriptor_72ba5acb6994ddef, []int{13}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,6 +658,20 @@ func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
 	return 0
 }
 
+func (m *QueryMissCounterResponse) GetOutOfBandCounter() uint64 {
+	if m != nil {
+		return m.OutOfBandCounter
+	}
+	return 0
+}
+
+func (m *QueryMissCounterResponse) GetOffenseCounter() uint64 {
+	if m != nil {
+		return m.OffenseCounter
+	}
+	return 0
+}
+
 // QueryAggregatePrevoteRequest is the request type for the
 // Query/AggregatePrevote RPC method.
 type QueryAggregatePrevoteRequest struct {
@@ -389,7 +683,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{8}
+	return fileDescriptor_72ba5acb6994ddef, []int{14}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +724,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{9}
+	return fileDescriptor_72ba5acb6994ddef, []int{15}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +769,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{10}
+	return fileDescriptor_72ba5acb6994ddef, []int{16}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,7 +810,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{11}
+	return fileDescriptor_72ba5acb6994ddef, []int{17}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +857,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{12}
+	return fileDescriptor_72ba5acb6994ddef, []int{18}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +898,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{13}
+	return fileDescriptor_72ba5acb6994ddef, []int{19}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +943,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{14}
+	return fileDescriptor_72ba5acb6994ddef, []int{20}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +984,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{15}
+	return fileDescriptor_72ba5acb6994ddef, []int{21}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +1028,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{16}
+	return fileDescriptor_72ba5acb6994ddef, []int{22}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +1067,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72ba5acb6994ddef, []int{17}
+	return fileDescriptor_72ba5acb6994ddef, []int{23}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -814,6 +1108,12 @@ func init() {
 	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "umeenetwork.umee.oracle.v1beta1.QueryExchangeRatesResponse")
 	proto.RegisterType((*QueryActiveExchangeRatesRequest)(nil), "umeenetwork.umee.oracle.v1beta1.QueryActiveExchangeRatesRequest")
 	proto.RegisterType((*QueryActiveExchangeRatesResponse)(nil), "umeenetwork.umee.oracle.v1beta1.QueryActiveExchangeRatesResponse")
+	proto.RegisterType((*QueryExchangeRateDerivationsRequest)(nil), "umeenetwork.umee.oracle.v1beta1.QueryExchangeRateDerivationsRequest")
+	proto.RegisterType((*QueryExchangeRateDerivationsResponse)(nil), "umeenetwork.umee.oracle.v1beta1.QueryExchangeRateDerivationsResponse")
+	proto.RegisterType((*QueryExchangeRateOverridesRequest)(nil), "umeenetwork.umee.oracle.v1beta1.QueryExchangeRateOverridesRequest")
+	proto.RegisterType((*QueryExchangeRateOverridesResponse)(nil), "umeenetwork.umee.oracle.v1beta1.QueryExchangeRateOverridesResponse")
+	proto.RegisterType((*QueryRewardPoolsRequest)(nil), "umeenetwork.umee.oracle.v1beta1.QueryRewardPoolsRequest")
+	proto.RegisterType((*QueryRewardPoolsResponse)(nil), "umeenetwork.umee.oracle.v1beta1.QueryRewardPoolsResponse")
 	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "umeenetwork.umee.oracle.v1beta1.QueryFeederDelegationRequest")
 	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "umeenetwork.umee.oracle.v1beta1.QueryFeederDelegationResponse")
 	proto.RegisterType((*QueryMissCounterRequest)(nil), "umeenetwork.umee.oracle.v1beta1.QueryMissCounterRequest")
@@ -833,68 +1133,85 @@ func init() {
 func init() { proto.RegisterFile("umee/oracle/v1beta1/query.proto", fileDescriptor_72ba5acb6994ddef) }
 
 var fileDescriptor_72ba5acb6994ddef = []byte{
-	// 964 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xde, 0x81, 0x36, 0xa2, 0x6f, 0xd9, 0x25, 0x99, 0x46, 0x22, 0xb8, 0xa9, 0x9d, 0x1a, 0x21,
-	0x2a, 0xa1, 0xda, 0x4a, 0x52, 0x14, 0x92, 0x12, 0xd2, 0x4d, 0x13, 0x90, 0x2a, 0x10, 0x65, 0x0f,
-	0x3d, 0x20, 0xa4, 0x68, 0x62, 0x0f, 0xae, 0xd5, 0xac, 0x67, 0xeb, 0xf1, 0x86, 0x54, 0x55, 0x2f,
-	0x48, 0x48, 0x3d, 0x82, 0x40, 0x88, 0x63, 0x8f, 0x88, 0xff, 0x02, 0xa9, 0x87, 0x1c, 0x03, 0x08,
-	0x89, 0x0b, 0x3f, 0x94, 0x70, 0xe0, 0xcf, 0x40, 0x9e, 0x19, 0x7b, 0xed, 0x5d, 0x9b, 0x75, 0x1c,
-	0x4e, 0xeb, 0x9d, 0xf7, 0xde, 0x37, 0xdf, 0x37, 0xcf, 0xf3, 0x3e, 0x19, 0x8c, 0x41, 0x8f, 0x52,
-	0x9b, 0x85, 0xc4, 0xd9, 0xa3, 0xf6, 0xfe, 0xe2, 0x2e, 0x8d, 0xc8, 0xa2, 0xfd, 0x60, 0x40, 0xc3,
-	0x87, 0x56, 0x3f, 0x64, 0x11, 0xc3, 0x22, 0x21, 0xa0, 0xd1, 0x67, 0x2c, 0xbc, 0x6f, 0xc5, 0xcf,
-	0x96, 0x4c, 0xb6, 0x54, 0xb2, 0x36, 0xeb, 0x31, 0x8f, 0x89, 0x5c, 0x3b, 0x7e, 0x92, 0x65, 0xda,
-	0xbc, 0xc7, 0x98, 0xb7, 0x47, 0x6d, 0xd2, 0xf7, 0x6d, 0x12, 0x04, 0x2c, 0x22, 0x91, 0xcf, 0x02,
-	0xae, 0xa2, 0x0b, 0x45, 0xbb, 0x2a, 0x5c, 0x99, 0xa1, 0x3b, 0x8c, 0xf7, 0x18, 0xb7, 0x77, 0x09,
-	0x1f, 0x66, 0x38, 0xcc, 0x0f, 0x64, 0xdc, 0xbc, 0x01, 0xaf, 0x7c, 0x14, 0xb3, 0xdc, 0x3e, 0x70,
-	0xee, 0x91, 0xc0, 0xa3, 0x5d, 0x12, 0x51, 0xde, 0xa5, 0x0f, 0x06, 0x94, 0x47, 0x78, 0x16, 0xce,
-	0xbb, 0x34, 0x60, 0xbd, 0x39, 0xb4, 0x80, 0xae, 0x5e, 0xe8, 0xca, 0x3f, 0x6b, 0x2f, 0x3c, 0x79,
-	0x6a, 0x34, 0xfe, 0x79, 0x6a, 0x34, 0xcc, 0x6f, 0x11, 0x68, 0x45, 0xd5, 0xbc, 0xcf, 0x02, 0x4e,
-	0xf1, 0x01, 0xb4, 0xa9, 0x0a, 0xec, 0x84, 0x71, 0x64, 0x0e, 0x2d, 0x3c, 0x7f, 0xb5, 0xb9, 0x34,
-	0x6f, 0x49, 0x52, 0x56, 0x4c, 0x2a, 0xd1, 0x6f, 0x6d, 0x51, 0xe7, 0x16, 0xf3, 0x83, 0xcd, 0xe5,
-	0xc3, 0x3f, 0x8c, 0xc6, 0x0f, 0x7f, 0x1a, 0x6f, 0x78, 0x7e, 0x74, 0x6f, 0xb0, 0x6b, 0x39, 0xac,
-	0x67, 0x2b, 0x11, 0xf2, 0xe7, 0x1a, 0x77, 0xef, 0xdb, 0xd1, 0xc3, 0x3e, 0xe5, 0x49, 0x0d, 0xef,
-	0xb6, 0x68, 0x96, 0x81, 0x79, 0x05, 0x0c, 0xc1, 0xab, 0xe3, 0x44, 0xfe, 0x3e, 0x2d, 0xd2, 0x66,
-	0x6e, 0xc3, 0x42, 0x79, 0x8a, 0x12, 0x70, 0x05, 0x5e, 0x24, 0x22, 0x9c, 0xa1, 0x7f, 0xa1, 0xdb,
-	0x94, 0x6b, 0x72, 0xa7, 0x0f, 0x61, 0x5e, 0xc0, 0xbc, 0x4b, 0xa9, 0x4b, 0xc3, 0x2d, 0xba, 0x47,
-	0x3d, 0xd1, 0xa1, 0xe4, 0x08, 0x5f, 0x83, 0xf6, 0x3e, 0xd9, 0xf3, 0x5d, 0x12, 0xb1, 0x70, 0x87,
-	0xb8, 0x6e, 0xa8, 0xce, 0xb2, 0x95, 0xae, 0x76, 0x5c, 0x37, 0xcc, 0x9c, 0xe9, 0x4d, 0xb8, 0x5c,
-	0x02, 0xa8, 0x48, 0x19, 0xd0, 0xfc, 0x54, 0xc4, 0xb2, 0x70, 0x20, 0x97, 0x62, 0x2c, 0xf3, 0x36,
-	0xbc, 0x2c, 0x10, 0x3e, 0xf0, 0x39, 0xbf, 0xc5, 0x06, 0x41, 0x44, 0xc3, 0xda, 0x6c, 0xd6, 0x61,
-	0x6e, 0x1c, 0x6b, 0x78, 0x3a, 0x3d, 0x9f, 0xf3, 0x1d, 0x47, 0xae, 0x0b, 0xa8, 0x73, 0xdd, 0x66,
-	0x6f, 0x98, 0x9a, 0x9e, 0x4e, 0xc7, 0xf3, 0xc2, 0x58, 0x07, 0xbd, 0x13, 0xd2, 0x7d, 0x16, 0xd1,
-	0xda, 0x7c, 0xbe, 0x42, 0xea, 0x78, 0xc6, 0x11, 0x15, 0xab, 0x3e, 0xcc, 0x90, 0x24, 0xb6, 0xd3,
-	0x97, 0x41, 0x81, 0xda, 0x5c, 0x5a, 0xb7, 0x26, 0xdc, 0x41, 0x2b, 0x45, 0xcd, 0xbe, 0x0f, 0x6a,
-	0x87, 0xcd, 0x73, 0xf1, 0x8b, 0xd9, 0x9d, 0x26, 0x23, 0x3b, 0x9b, 0x46, 0x09, 0xa5, 0xf4, 0x55,
-	0xfb, 0x06, 0x81, 0x5e, 0x96, 0xa1, 0x58, 0x87, 0x80, 0xc7, 0x58, 0x27, 0xd7, 0xe5, 0x7f, 0xa1,
-	0x3d, 0x33, 0x4a, 0x9b, 0x9b, 0xef, 0xab, 0xab, 0x9f, 0x56, 0xdf, 0x3d, 0x4b, 0x67, 0xbe, 0x48,
-	0x66, 0xc1, 0x08, 0x9c, 0x12, 0xe8, 0x41, 0x7b, 0x28, 0x30, 0xd3, 0x93, 0xb5, 0x7a, 0xe2, 0xee,
-	0x0e, 0x95, 0xb5, 0x48, 0x76, 0x43, 0x73, 0xbe, 0x88, 0x46, 0xda, 0x8a, 0x27, 0x08, 0x2e, 0x15,
-	0x86, 0x15, 0x4d, 0x1f, 0x5e, 0xca, 0xd3, 0x4c, 0x9a, 0x70, 0x76, 0x9e, 0xed, 0x1c, 0x4f, 0x6e,
-	0xce, 0x02, 0x16, 0x4c, 0xee, 0x90, 0x90, 0xf4, 0x52, 0x82, 0x9f, 0xc0, 0xc5, 0xdc, 0xaa, 0xe2,
-	0xb5, 0x0d, 0x53, 0x7d, 0xb1, 0xa2, 0x8e, 0xed, 0xf5, 0x89, 0x74, 0x24, 0x80, 0xda, 0x5b, 0x15,
-	0x2f, 0x7d, 0xdf, 0x86, 0xf3, 0x02, 0x1e, 0x3f, 0x43, 0xd0, 0xca, 0x0d, 0x3d, 0x3c, 0x59, 0x61,
-	0xa9, 0x51, 0x68, 0x37, 0x6a, 0xd5, 0x4a, 0x6d, 0xe6, 0xda, 0xe7, 0xbf, 0xfc, 0xfd, 0xf5, 0x73,
-	0xd7, 0xf1, 0x92, 0x5d, 0xe4, 0x66, 0xc2, 0x73, 0xb8, 0x9d, 0x37, 0x12, 0xfb, 0x91, 0x58, 0x7e,
-	0x8c, 0x7f, 0x45, 0x70, 0xb1, 0x60, 0x82, 0xe3, 0x9b, 0xd5, 0x08, 0x95, 0xfb, 0x83, 0xd6, 0x39,
-	0x03, 0x82, 0x12, 0xb6, 0x2a, 0x84, 0x2d, 0xe3, 0xc5, 0xff, 0x12, 0xa6, 0x0c, 0x26, 0xaf, 0x0f,
-	0xff, 0x8c, 0x60, 0x7a, 0xd4, 0x01, 0xf0, 0x7a, 0x35, 0x4a, 0x25, 0x56, 0xa4, 0xbd, 0x53, 0xb7,
-	0x5c, 0xc9, 0xd9, 0x10, 0x72, 0x56, 0xf1, 0x4a, 0xa1, 0x9c, 0x74, 0x2e, 0x70, 0xfb, 0x51, 0x7e,
-	0x72, 0x3c, 0xb6, 0xa5, 0x39, 0xe1, 0x1f, 0x11, 0x34, 0x33, 0x46, 0x82, 0xdf, 0xaa, 0x46, 0x68,
-	0xdc, 0xc7, 0xb4, 0xd5, 0x1a, 0x95, 0x4a, 0xc5, 0xba, 0x50, 0xb1, 0x82, 0xdf, 0x3c, 0xb5, 0x8a,
-	0xd8, 0xd8, 0xf0, 0xef, 0x08, 0xa6, 0x47, 0xc7, 0x78, 0xd5, 0xc6, 0x94, 0xb8, 0x60, 0xd5, 0xc6,
-	0x94, 0x59, 0x9e, 0x79, 0x5b, 0x48, 0xda, 0xc2, 0x9b, 0xa7, 0x96, 0x34, 0xe6, 0x39, 0xf8, 0x08,
-	0xc1, 0xcc, 0x98, 0x4d, 0xe1, 0x9a, 0x0c, 0xd3, 0xcb, 0xb4, 0x51, 0xbb, 0xbe, 0xd2, 0x8c, 0xc8,
-	0x48, 0x1c, 0x77, 0x51, 0xfc, 0x13, 0x82, 0x56, 0x6e, 0xdc, 0x57, 0x1d, 0x75, 0x45, 0xc6, 0x58,
-	0x75, 0xd4, 0x15, 0xba, 0xa0, 0xf9, 0x9e, 0x90, 0xd1, 0xc1, 0x1b, 0x65, 0x32, 0x5c, 0x7f, 0x62,
-	0xa7, 0x44, 0x9b, 0x9e, 0x21, 0x68, 0xe7, 0x2d, 0x0c, 0xd7, 0x21, 0x96, 0x36, 0xe8, 0xed, 0x7a,
-	0xc5, 0x4a, 0xd6, 0x8a, 0x90, 0xb5, 0x88, 0xed, 0xea, 0xdd, 0x91, 0xad, 0xf9, 0x0e, 0xc1, 0x94,
-	0x34, 0x2a, 0xbc, 0x5c, 0x8d, 0x41, 0xce, 0x2d, 0xb5, 0xeb, 0xa7, 0x2b, 0x52, 0x74, 0x5f, 0x15,
-	0x74, 0x2f, 0xe3, 0x4b, 0x85, 0x74, 0xa5, 0x55, 0x6e, 0x6e, 0x1f, 0x1e, 0xeb, 0xe8, 0xe8, 0x58,
-	0x47, 0x7f, 0x1d, 0xeb, 0xe8, 0xcb, 0x13, 0xbd, 0x71, 0x74, 0xa2, 0x37, 0x7e, 0x3b, 0xd1, 0x1b,
-	0x1f, 0x67, 0x3f, 0x4c, 0x62, 0x80, 0x6b, 0x6a, 0x7f, 0x89, 0x76, 0x90, 0xe0, 0x89, 0x2f, 0x94,
-	0xdd, 0x29, 0xf1, 0x99, 0xb5, 0xfc, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x5b, 0x52, 0x5b,
-	0x20, 0x0e, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdf, 0x4f, 0xdb, 0xd6,
+	0x17, 0xcf, 0xed, 0x0f, 0xf4, 0xe5, 0xa4, 0xa4, 0x70, 0xe1, 0xab, 0x32, 0x17, 0x12, 0x30, 0x9b,
+	0xca, 0x84, 0x88, 0x05, 0x14, 0x31, 0x60, 0x94, 0x12, 0x92, 0x4d, 0xaa, 0x36, 0xc1, 0xa2, 0xa9,
+	0xd2, 0xa6, 0x49, 0x91, 0x89, 0x2f, 0xa9, 0x55, 0xe2, 0x9b, 0xfa, 0x3a, 0x29, 0x55, 0x55, 0x4d,
+	0x9a, 0x34, 0xad, 0x8f, 0x9b, 0xb6, 0x49, 0x7b, 0xe4, 0x79, 0xd2, 0xfe, 0x88, 0x69, 0x7d, 0xe8,
+	0x23, 0xdb, 0x34, 0x69, 0x2f, 0xfb, 0x21, 0xd8, 0xa4, 0xfd, 0x19, 0x93, 0xef, 0xbd, 0x76, 0x9c,
+	0xc4, 0x26, 0xc6, 0xec, 0xa9, 0xe9, 0xb9, 0xe7, 0x7c, 0xce, 0xe7, 0x73, 0x8e, 0xcd, 0xfd, 0xc8,
+	0x90, 0x6b, 0xd6, 0x09, 0xd1, 0xa8, 0xad, 0x57, 0x0f, 0x88, 0xd6, 0x5a, 0xd8, 0x23, 0x8e, 0xbe,
+	0xa0, 0x3d, 0x6a, 0x12, 0xfb, 0x49, 0xbe, 0x61, 0x53, 0x87, 0x62, 0x9e, 0x60, 0x11, 0xe7, 0x31,
+	0xb5, 0x1f, 0xe6, 0xdd, 0xdf, 0x79, 0x91, 0x9c, 0x97, 0xc9, 0xca, 0x58, 0x8d, 0xd6, 0x28, 0xcf,
+	0xd5, 0xdc, 0x5f, 0xa2, 0x4c, 0x99, 0xa8, 0x51, 0x5a, 0x3b, 0x20, 0x9a, 0xde, 0x30, 0x35, 0xdd,
+	0xb2, 0xa8, 0xa3, 0x3b, 0x26, 0xb5, 0x98, 0x3c, 0x9d, 0x0a, 0xeb, 0x2a, 0x71, 0x45, 0x46, 0xb6,
+	0x4a, 0x59, 0x9d, 0x32, 0x6d, 0x4f, 0x67, 0xed, 0x8c, 0x2a, 0x35, 0x2d, 0x71, 0xae, 0xae, 0xc3,
+	0x2b, 0xef, 0xb9, 0x2c, 0x4b, 0x87, 0xd5, 0x07, 0xba, 0x55, 0x23, 0x65, 0xdd, 0x21, 0xac, 0x4c,
+	0x1e, 0x35, 0x09, 0x73, 0xf0, 0x18, 0x5c, 0x35, 0x88, 0x45, 0xeb, 0xe3, 0x68, 0x0a, 0xcd, 0x0e,
+	0x96, 0xc5, 0x7f, 0xd6, 0xfe, 0xf7, 0xfc, 0x28, 0x97, 0xfa, 0xe7, 0x28, 0x97, 0x52, 0x7f, 0x40,
+	0xa0, 0x84, 0x55, 0xb3, 0x06, 0xb5, 0x18, 0xc1, 0x87, 0x90, 0x21, 0xf2, 0xa0, 0x62, 0xbb, 0x27,
+	0xe3, 0x68, 0xea, 0xf2, 0x6c, 0x7a, 0x71, 0x22, 0x2f, 0x48, 0xe5, 0x5d, 0x52, 0x9e, 0xfe, 0x7c,
+	0x91, 0x54, 0xb7, 0xa9, 0x69, 0x15, 0x96, 0x5e, 0xfe, 0x9e, 0x4b, 0x7d, 0xfb, 0x47, 0x6e, 0xae,
+	0x66, 0x3a, 0x0f, 0x9a, 0x7b, 0xf9, 0x2a, 0xad, 0x6b, 0x52, 0x84, 0xf8, 0x67, 0x9e, 0x19, 0x0f,
+	0x35, 0xe7, 0x49, 0x83, 0x30, 0xaf, 0x86, 0x95, 0x87, 0x48, 0x90, 0x01, 0x9e, 0x83, 0x11, 0xda,
+	0x22, 0xb6, 0x6d, 0x1a, 0x06, 0xb1, 0x2a, 0x9c, 0x36, 0x1b, 0xbf, 0x34, 0x75, 0x79, 0x76, 0xb0,
+	0x3c, 0xdc, 0x3e, 0x28, 0xf2, 0xb8, 0x3a, 0x0d, 0x39, 0x2e, 0x62, 0xab, 0xea, 0x98, 0x2d, 0x12,
+	0x36, 0x08, 0xb5, 0x04, 0x53, 0xd1, 0x29, 0x52, 0xed, 0x34, 0x5c, 0xd3, 0xf9, 0x71, 0x40, 0xeb,
+	0x60, 0x39, 0x2d, 0x62, 0x3c, 0x55, 0x2d, 0xc1, 0x4c, 0xcf, 0xb8, 0x8a, 0xc4, 0x36, 0x5b, 0x62,
+	0xa9, 0x71, 0xc7, 0xfe, 0x19, 0x82, 0x57, 0xcf, 0xc6, 0x91, 0x94, 0x2a, 0x90, 0x36, 0xda, 0x61,
+	0x39, 0xfd, 0x95, 0x7c, 0x9f, 0x27, 0x31, 0x1f, 0x0e, 0x5b, 0xb8, 0xe2, 0x2e, 0xa6, 0x1c, 0x44,
+	0x54, 0xb7, 0x61, 0xba, 0x87, 0xc8, 0x8e, 0x98, 0x6f, 0xfc, 0xa7, 0xe8, 0x63, 0x50, 0xcf, 0x02,
+	0x91, 0x5a, 0x3e, 0x80, 0x41, 0xea, 0x05, 0xa5, 0x92, 0xe5, 0x73, 0x29, 0xf1, 0x20, 0xa5, 0x8e,
+	0x36, 0x9a, 0xba, 0x0a, 0x37, 0x38, 0x81, 0x32, 0x79, 0xac, 0xdb, 0xc6, 0x2e, 0xa5, 0x07, 0xb1,
+	0xb9, 0x37, 0x60, 0xbc, 0xb7, 0x54, 0x32, 0x7e, 0x1f, 0xae, 0xd9, 0x3c, 0x5c, 0x69, 0xb8, 0x71,
+	0x49, 0x7a, 0xae, 0x2f, 0xe9, 0x36, 0x96, 0x37, 0x72, 0xbb, 0x8d, 0xae, 0xee, 0xc0, 0x04, 0xef,
+	0xf8, 0x16, 0x21, 0x06, 0xb1, 0x8b, 0xe4, 0x80, 0xd4, 0xf8, 0x32, 0x3c, 0xc6, 0xaf, 0x41, 0xa6,
+	0xa5, 0x1f, 0x98, 0x86, 0xee, 0x50, 0xbb, 0xa2, 0x1b, 0x86, 0x2d, 0xa9, 0x0f, 0xf9, 0xd1, 0x2d,
+	0xc3, 0xb0, 0x03, 0x12, 0xee, 0xc2, 0x64, 0x04, 0xa0, 0xd4, 0x91, 0x83, 0xf4, 0x3e, 0x3f, 0x0b,
+	0xc2, 0x81, 0x08, 0xb9, 0x58, 0xea, 0x3d, 0x39, 0xbf, 0x77, 0x4d, 0xc6, 0xb6, 0x69, 0xd3, 0x72,
+	0x88, 0x9d, 0x98, 0xcd, 0xd7, 0x08, 0xc6, 0x7b, 0xc1, 0xda, 0xaf, 0x58, 0xdd, 0x64, 0xac, 0x52,
+	0x15, 0x71, 0x8e, 0x75, 0xa5, 0x9c, 0xae, 0xb7, 0x53, 0xf1, 0x3c, 0x8c, 0xd2, 0xa6, 0x53, 0xa1,
+	0xfb, 0x95, 0x3d, 0xdd, 0x32, 0xfc, 0xcc, 0x4b, 0x3c, 0x73, 0x98, 0x36, 0x9d, 0x9d, 0xfd, 0x82,
+	0x6e, 0x19, 0x5e, 0xfa, 0x2d, 0xb8, 0x4e, 0xf7, 0xf7, 0x89, 0xc5, 0x88, 0x9f, 0x7a, 0x99, 0xa7,
+	0x66, 0x64, 0x58, 0x26, 0xfa, 0x63, 0xdf, 0xaa, 0xd5, 0x6c, 0x77, 0x40, 0x64, 0xd7, 0x26, 0x2d,
+	0xea, 0x90, 0xc4, 0x42, 0xbf, 0x40, 0x30, 0x19, 0x81, 0x28, 0xd5, 0x36, 0x60, 0x44, 0xf7, 0xce,
+	0x2a, 0x0d, 0x71, 0xc8, 0x51, 0xd3, 0x8b, 0x1b, 0x7d, 0x1f, 0x22, 0x1f, 0x35, 0xf8, 0x0a, 0xc8,
+	0x0e, 0xf2, 0xb1, 0x1a, 0xd6, 0xbb, 0x3a, 0xab, 0xb9, 0x08, 0x4a, 0xfe, 0xdf, 0xc1, 0xaf, 0x10,
+	0x64, 0xa3, 0x32, 0x24, 0x6b, 0x1b, 0x70, 0x0f, 0x6b, 0xef, 0xd9, 0xff, 0x4f, 0x68, 0x8f, 0x74,
+	0xd3, 0x66, 0xea, 0x3b, 0xf2, 0x12, 0xf3, 0xab, 0xef, 0x5f, 0x64, 0x33, 0x9f, 0x7a, 0xb7, 0x5a,
+	0x17, 0x9c, 0x14, 0x58, 0x83, 0x4c, 0x5b, 0x60, 0x60, 0x27, 0x6b, 0xc9, 0xc4, 0xdd, 0x6f, 0x2b,
+	0x1b, 0xd2, 0x83, 0x0d, 0xd5, 0x89, 0x30, 0x1a, 0xfe, 0x2a, 0x9e, 0x23, 0xb8, 0x19, 0x7a, 0x2c,
+	0x69, 0x9a, 0x70, 0xbd, 0x93, 0xa6, 0xb7, 0x84, 0x8b, 0xf3, 0xcc, 0x74, 0xf0, 0x64, 0xea, 0x18,
+	0x60, 0xce, 0x64, 0x57, 0xb7, 0xf5, 0xba, 0x4f, 0xf0, 0x23, 0x18, 0xed, 0x88, 0x4a, 0x5e, 0x25,
+	0x18, 0x68, 0xf0, 0x88, 0x1c, 0xdb, 0xad, 0xbe, 0x74, 0x04, 0x80, 0xec, 0x2d, 0x8b, 0x17, 0x8f,
+	0x46, 0xe1, 0x2a, 0x87, 0xc7, 0x2f, 0x10, 0x0c, 0x75, 0xdc, 0xc8, 0xb8, 0xbf, 0xc2, 0x48, 0xcb,
+	0xa3, 0xac, 0x27, 0xaa, 0x15, 0xda, 0xd4, 0xb5, 0x4f, 0x7e, 0xfe, 0xeb, 0xcb, 0x4b, 0xb7, 0xf1,
+	0xa2, 0x16, 0xe6, 0xcb, 0x84, 0x0d, 0xd1, 0x3a, 0x2d, 0x91, 0xf6, 0x94, 0x87, 0x9f, 0xe1, 0x5f,
+	0x10, 0x8c, 0x86, 0xd8, 0x0b, 0x7c, 0x37, 0x1e, 0xa1, 0x68, 0xf3, 0xa2, 0x6c, 0x5d, 0x00, 0x41,
+	0x0a, 0x5b, 0xe5, 0xc2, 0x96, 0xf0, 0xc2, 0x59, 0xc2, 0xa4, 0xfb, 0xe9, 0xd4, 0x87, 0xff, 0x46,
+	0x70, 0x23, 0xc2, 0xa7, 0xe0, 0xe2, 0xf9, 0x87, 0xdd, 0x6b, 0x97, 0x94, 0xd2, 0x05, 0x51, 0xa4,
+	0xc6, 0x0d, 0xae, 0x71, 0x05, 0x2f, 0xc7, 0x5e, 0x5e, 0x25, 0x60, 0x85, 0xf0, 0x6f, 0x08, 0xfe,
+	0x1f, 0xea, 0x60, 0x70, 0xe1, 0xfc, 0xfc, 0xba, 0x3d, 0x94, 0xb2, 0x7d, 0x21, 0x0c, 0xa9, 0x70,
+	0x9d, 0x2b, 0x5c, 0xc6, 0x4b, 0xf1, 0x15, 0xfa, 0x26, 0x09, 0x7f, 0x87, 0x20, 0x1d, 0x70, 0x39,
+	0xf8, 0x8d, 0x78, 0x8c, 0x7a, 0x3d, 0x95, 0xb2, 0x9a, 0xa0, 0x52, 0x2a, 0x78, 0x9d, 0x2b, 0x98,
+	0xc1, 0xd3, 0xa1, 0x0a, 0x82, 0x6e, 0x0b, 0xff, 0x84, 0x60, 0xb8, 0xdb, 0xd2, 0xe0, 0x8d, 0x78,
+	0xad, 0x23, 0xbc, 0x95, 0x72, 0x27, 0x69, 0xb9, 0xa4, 0xbf, 0xc9, 0xe9, 0xaf, 0xe2, 0x95, 0x50,
+	0xfa, 0xfe, 0x7d, 0xc4, 0xb4, 0xa7, 0x9d, 0x37, 0xd6, 0x33, 0x4d, 0xb8, 0x2d, 0xfc, 0x3d, 0x82,
+	0x74, 0xc0, 0x18, 0xc5, 0x5d, 0x42, 0xaf, 0x31, 0x53, 0x56, 0x13, 0x54, 0xc6, 0x7a, 0x51, 0xce,
+	0x52, 0xe1, 0x1a, 0x35, 0xf7, 0x45, 0x19, 0xee, 0xb6, 0x0f, 0x71, 0x17, 0x13, 0xe1, 0xbe, 0x94,
+	0x3b, 0x49, 0xcb, 0xa5, 0xa4, 0x7b, 0x5c, 0x52, 0x11, 0x17, 0xce, 0x2d, 0xa9, 0xc7, 0xeb, 0xe0,
+	0x63, 0x04, 0x23, 0xdd, 0x8d, 0x18, 0x4e, 0xc8, 0xd0, 0x7f, 0x69, 0x36, 0x13, 0xd7, 0xc7, 0xba,
+	0x9b, 0x02, 0x12, 0x7b, 0xdd, 0x1b, 0xfe, 0x11, 0xc1, 0x50, 0x87, 0xcd, 0x88, 0x7b, 0xc5, 0x86,
+	0x19, 0x32, 0x65, 0x3d, 0x51, 0xad, 0x94, 0xf1, 0x36, 0x97, 0xb1, 0x85, 0x37, 0xa3, 0x64, 0x18,
+	0x66, 0xdf, 0x4d, 0xf1, 0x35, 0xbd, 0x40, 0x90, 0xe9, 0x68, 0xc1, 0x70, 0x12, 0x62, 0xfe, 0x82,
+	0xde, 0x4c, 0x56, 0x2c, 0x65, 0xad, 0x70, 0x59, 0x0b, 0x58, 0x8b, 0xbf, 0x1d, 0xb1, 0x9a, 0x6f,
+	0x10, 0x0c, 0x08, 0x83, 0x84, 0x97, 0xe2, 0x31, 0xe8, 0x70, 0x69, 0xca, 0xed, 0xf3, 0x15, 0x49,
+	0xba, 0x33, 0x9c, 0xee, 0x24, 0xbe, 0x19, 0x4a, 0x57, 0x58, 0xb4, 0x42, 0xe9, 0xe5, 0x49, 0x16,
+	0x1d, 0x9f, 0x64, 0xd1, 0x9f, 0x27, 0x59, 0xf4, 0xf9, 0x69, 0x36, 0x75, 0x7c, 0x9a, 0x4d, 0xfd,
+	0x7a, 0x9a, 0x4d, 0x7d, 0x18, 0xfc, 0xb4, 0xe3, 0x02, 0xcc, 0xcb, 0xfe, 0x02, 0xed, 0xd0, 0xc3,
+	0xe3, 0xdf, 0x78, 0xf6, 0x06, 0xf8, 0x87, 0xaa, 0xa5, 0x7f, 0x07, 0x00, 0x7e, 0xc8, 0x1c, 0xe3,
+	0x62, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -914,6 +1231,15 @@ type QueryClient interface {
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
 	// ActiveExchangeRates returns all active denoms
 	ActiveExchangeRates(ctx context.Context, in *QueryActiveExchangeRatesRequest, opts ...grpc.CallOption) (*QueryActiveExchangeRatesResponse, error)
+	// ExchangeRateDerivations returns the derivation paths of all exchange rates
+	// derived from cross pairs, or, if specified, returns a single denom
+	ExchangeRateDerivations(ctx context.Context, in *QueryExchangeRateDerivationsRequest, opts ...grpc.CallOption) (*QueryExchangeRateDerivationsResponse, error)
+	// ExchangeRateOverrides returns the exchange rate overrides of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRateOverrides(ctx context.Context, in *QueryExchangeRateOverridesRequest, opts ...grpc.CallOption) (*QueryExchangeRateOverridesResponse, error)
+	// RewardPools returns the reward pools of all denoms, or, if specified,
+	// returns a single denom
+	RewardPools(ctx context.Context, in *QueryRewardPoolsRequest, opts ...grpc.CallOption) (*QueryRewardPoolsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
@@ -956,6 +1282,33 @@ func (c *queryClient) ActiveExchangeRates(ctx context.Context, in *QueryActiveEx
 	return out, nil
 }
 
+func (c *queryClient) ExchangeRateDerivations(ctx context.Context, in *QueryExchangeRateDerivationsRequest, opts ...grpc.CallOption) (*QueryExchangeRateDerivationsResponse, error) {
+	out := new(QueryExchangeRateDerivationsResponse)
+	err := c.cc.Invoke(ctx, "/umeenetwork.umee.oracle.v1beta1.Query/ExchangeRateDerivations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRateOverrides(ctx context.Context, in *QueryExchangeRateOverridesRequest, opts ...grpc.CallOption) (*QueryExchangeRateOverridesResponse, error) {
+	out := new(QueryExchangeRateOverridesResponse)
+	err := c.cc.Invoke(ctx, "/umeenetwork.umee.oracle.v1beta1.Query/ExchangeRateOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPools(ctx context.Context, in *QueryRewardPoolsRequest, opts ...grpc.CallOption) (*QueryRewardPoolsResponse, error) {
+	out := new(QueryRewardPoolsResponse)
+	err := c.cc.Invoke(ctx, "/umeenetwork.umee.oracle.v1beta1.Query/RewardPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
 	out := new(QueryFeederDelegationResponse)
 	err := c.cc.Invoke(ctx, "/umeenetwork.umee.oracle.v1beta1.Query/FeederDelegation", in, out, opts...)
@@ -1026,6 +1379,15 @@ type QueryServer interface {
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
 	// ActiveExchangeRates returns all active denoms
 	ActiveExchangeRates(context.Context, *QueryActiveExchangeRatesRequest) (*QueryActiveExchangeRatesResponse, error)
+	// ExchangeRateDerivations returns the derivation paths of all exchange rates
+	// derived from cross pairs, or, if specified, returns a single denom
+	ExchangeRateDerivations(context.Context, *QueryExchangeRateDerivationsRequest) (*QueryExchangeRateDerivationsResponse, error)
+	// ExchangeRateOverrides returns the exchange rate overrides of all denoms,
+	// or, if specified, returns a single denom
+	ExchangeRateOverrides(context.Context, *QueryExchangeRateOverridesRequest) (*QueryExchangeRateOverridesResponse, error)
+	// RewardPools returns the reward pools of all denoms, or, if specified,
+	// returns a single denom
+	RewardPools(context.Context, *QueryRewardPoolsRequest) (*QueryRewardPoolsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
@@ -1052,6 +1414,15 @@ func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryEx
 func (*UnimplementedQueryServer) ActiveExchangeRates(ctx context.Context, req *QueryActiveExchangeRatesRequest) (*QueryActiveExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActiveExchangeRates not implemented")
 }
+func (*UnimplementedQueryServer) ExchangeRateDerivations(ctx context.Context, req *QueryExchangeRateDerivationsRequest) (*QueryExchangeRateDerivationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateDerivations not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRateOverrides(ctx context.Context, req *QueryExchangeRateOverridesRequest) (*QueryExchangeRateOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateOverrides not implemented")
+}
+func (*UnimplementedQueryServer) RewardPools(ctx context.Context, req *QueryRewardPoolsRequest) (*QueryRewardPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPools not implemented")
+}
 func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
 }
@@ -1114,6 +1485,60 @@ func _Query_ActiveExchangeRates_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExchangeRateDerivations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateDerivationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateDerivations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umeenetwork.umee.oracle.v1beta1.Query/ExchangeRateDerivations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateDerivations(ctx, req.(*QueryExchangeRateDerivationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRateOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umeenetwork.umee.oracle.v1beta1.Query/ExchangeRateOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateOverrides(ctx, req.(*QueryExchangeRateOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/umeenetwork.umee.oracle.v1beta1.Query/RewardPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPools(ctx, req.(*QueryRewardPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeederDelegationRequest)
 	if err := dec(in); err != nil {
@@ -1253,11 +1678,23 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_ActiveExchangeRates_Handler,
 		},
 		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
+			MethodName: "ExchangeRateDerivations",
+			Handler:    _Query_ExchangeRateDerivations_Handler,
 		},
 		{
-			MethodName: "MissCounter",
+			MethodName: "ExchangeRateOverrides",
+			Handler:    _Query_ExchangeRateOverrides_Handler,
+		},
+		{
+			MethodName: "RewardPools",
+			Handler:    _Query_RewardPools_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "MissCounter",
 			Handler:    _Query_MissCounter_Handler,
 		},
 		{
@@ -1335,6 +1772,15 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.OverriddenDenoms) > 0 {
+		for iNdEx := len(m.OverriddenDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OverriddenDenoms[iNdEx])
+			copy(dAtA[i:], m.OverriddenDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.OverriddenDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ExchangeRates) > 0 {
 		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1407,6 +1853,207 @@ func (m *QueryActiveExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExchangeRateDerivationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateDerivationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateDerivationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateDerivationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateDerivationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateDerivationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Derivations) > 0 {
+		for iNdEx := len(m.Derivations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Derivations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPools) > 0 {
+		for iNdEx := len(m.RewardPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1517,6 +2164,16 @@ func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.OffenseCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OffenseCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OutOfBandCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutOfBandCounter))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MissCounter != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
 		i--
@@ -1863,6 +2520,12 @@ func (m *QueryExchangeRatesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.OverriddenDenoms) > 0 {
+		for _, s := range m.OverriddenDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1890,106 +2553,117 @@ func (m *QueryActiveExchangeRatesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryFeederDelegationRequest) Size() (n int) {
+func (m *QueryExchangeRateDerivationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
+func (m *QueryExchangeRateDerivationsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Derivations) > 0 {
+		for _, e := range m.Derivations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryMissCounterRequest) Size() (n int) {
+func (m *QueryExchangeRateOverridesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
+func (m *QueryExchangeRateOverridesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+func (m *QueryRewardPoolsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+func (m *QueryRewardPoolsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RewardPools) > 0 {
+		for _, e := range m.RewardPools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+func (m *QueryFeederDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+func (m *QueryFeederDelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAggregateVoteRequest) Size() (n int) {
+func (m *QueryMissCounterRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2002,18 +2676,97 @@ func (m *QueryAggregateVoteRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
+func (m *QueryMissCounterResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	if m.OutOfBandCounter != 0 {
+		n += 1 + sovQuery(uint64(m.OutOfBandCounter))
+	}
+	if m.OffenseCounter != 0 {
+		n += 1 + sovQuery(uint64(m.OffenseCounter))
+	}
 	return n
 }
 
-func (m *QueryAggregateVotesRequest) Size() (n int) {
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2208,6 +2961,38 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverriddenDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverriddenDenoms = append(m.OverriddenDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2361,7 +3146,7 @@ func (m *QueryActiveExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateDerivationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,15 +3169,15 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateDerivationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateDerivationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2420,7 +3205,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2443,7 +3228,7 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateDerivationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2466,17 +3251,17 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateDerivationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateDerivationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Derivations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2486,23 +3271,25 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.Derivations = append(m.Derivations, ExchangeRateDerivation{})
+			if err := m.Derivations[len(m.Derivations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2525,7 +3312,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateOverridesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2548,15 +3335,15 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateOverridesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2584,7 +3371,7 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2607,7 +3394,7 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateOverridesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2630,17 +3417,17 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateOverridesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			m.MissCounter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2650,7 +3437,541 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, ExchangeRateOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPools = append(m.RewardPools, RewardPool{})
+			if err := m.RewardPools[len(m.RewardPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfBandCounter", wireType)
+			}
+			m.OutOfBandCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutOfBandCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenseCounter", wireType)
+			}
+			m.OffenseCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenseCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+)
+
+// NewRewardPool creates a RewardPool instance, projecting the share of its
+// balance that is paid out every vote period over the reward distribution
+// window.
+func NewRewardPool(balance sdk.Coin, ballotDenom string, votePeriod, rewardDistributionWindow uint64) RewardPool {
+	distributionRatio := sdk.NewDec(int64(votePeriod)).QuoInt64(int64(rewardDistributionWindow))
+
+	return RewardPool{
+		Balance:      balance,
+		BallotDenom:  ballotDenom,
+		PeriodReward: sdk.NewDecCoinFromDec(balance.Denom, sdk.NewDecFromInt(balance.Amount).Mul(distributionRatio)),
+	}
+}
+
+// String implements fmt.Stringer interface
+func (rp RewardPool) String() string {
+	out, _ := yaml.Marshal(rp)
+	return string(out)
+}